@@ -0,0 +1,42 @@
+package ion
+
+// ThemeSource determines which theme Electron uses for its own UI and
+// reports to web content.
+type ThemeSource string
+
+// Possible values for ThemeSource.
+const (
+	SystemThemeSource ThemeSource = "system"
+	LightThemeSource  ThemeSource = "light"
+	DarkThemeSource   ThemeSource = "dark"
+)
+
+// Appearance holds the current appearance settings.
+type Appearance struct {
+	DarkMode     bool `json:"darkMode"`
+	HighContrast bool `json:"highContrast"`
+}
+
+type themeArgs struct {
+	Source ThemeSource `json:"source"`
+}
+
+// Appearance returns the current appearance settings. Changes are reported
+// via event.AppearanceChanged events.
+func (ion *Ion) Appearance() (*Appearance, error) {
+	var appearance Appearance
+	if err := ion.invoke("appearance.get", nil, &appearance); err != nil {
+		return nil, err
+	}
+	return &appearance, nil
+}
+
+// SetThemeSource overrides the theme chosen by the system. Pass
+// SystemThemeSource to remove the override. This requires Electron 7 or
+// later, so it fails with the default provisioner.ElectronVersion unless a
+// newer one is selected with the ElectronVersion option. On macOS, older
+// versions that provide systemPreferences.setAppLevelAppearance are also
+// supported.
+func (ion *Ion) SetThemeSource(source ThemeSource) error {
+	return ion.invoke("appearance.setThemeSource", &themeArgs{Source: source}, nil)
+}
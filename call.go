@@ -0,0 +1,150 @@
+package ion
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/richardwilkes/ion/provisioner"
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+type request struct {
+	ID   int64       `json:"id"`
+	Cmd  string      `json:"cmd"`
+	Args interface{} `json:"args,omitempty"`
+}
+
+type reply struct {
+	ReplyTo int64           `json:"replyTo"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RemoteError    `json:"error,omitempty"`
+}
+
+// incoming holds any message sent from Electron. Replies fill out ReplyTo,
+// commands fill out Cmd and events fill out neither.
+type incoming struct {
+	reply
+	Cmd  string          `json:"cmd"`
+	Args json.RawMessage `json:"args"`
+}
+
+// RemoteError is returned when a command fails on the Electron side.
+type RemoteError struct {
+	Message string `json:"message"`
+	Stack   string `json:"stack,omitempty"`
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
+func (ion *Ion) nextID() int64 {
+	return atomic.AddInt64(&ion.lastID, 1)
+}
+
+// Call sends a command to Electron and waits for its reply. The command must
+// have been registered by a module provided via the Extensions option. If
+// the command succeeds and 'result' is not nil, the command's return value
+// will be unmarshaled into it.
+func (ion *Ion) Call(ctx context.Context, cmd string, args, result interface{}) error {
+	return ion.call(ctx, cmd, args, result)
+}
+
+// call sends a command to Electron and waits for its reply. Calls made
+// before Electron has connected will wait for the connection. Calls fail as
+// soon as the connection is lost. 'args' and 'result' may be nil.
+func (ion *Ion) call(ctx context.Context, cmd string, args, result interface{}) error {
+	select {
+	case <-ion.connected:
+	case <-ctx.Done():
+		return errs.Wrap(ctx.Err())
+	case <-ion.disconnected:
+		return errs.New("connection to " + provisioner.ElectronName + " closed")
+	case <-ion.ctx.Done():
+		return errs.New("ion has been shut down")
+	}
+	id := ion.nextID()
+	ch := make(chan *reply, 1)
+	ion.pendingLock.Lock()
+	ion.pending[id] = ch
+	ion.pendingLock.Unlock()
+	defer func() {
+		ion.pendingLock.Lock()
+		delete(ion.pending, id)
+		ion.pendingLock.Unlock()
+	}()
+	if err := ion.send(&request{ID: id, Cmd: cmd, Args: args}); err != nil {
+		return err
+	}
+	var r *reply
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		return errs.Wrap(ctx.Err())
+	case <-ion.disconnected:
+		// The reply may have arrived just before the connection closed.
+		select {
+		case r = <-ch:
+		default:
+			return errs.New("connection to " + provisioner.ElectronName + " closed")
+		}
+	case <-ion.ctx.Done():
+		return errs.New("ion has been shut down")
+	}
+	if r.Error != nil {
+		return errs.NewWithCause(cmd+" failed", r.Error)
+	}
+	if result != nil && len(r.Result) != 0 {
+		if err := json.Unmarshal(r.Result, result); err != nil {
+			return errs.NewWithCause("Invalid result data for "+cmd, err)
+		}
+	}
+	return nil
+}
+
+// invoke calls a command with a context bounded by the CallTimeout option,
+// for use by methods that do not take a context of their own.
+func (ion *Ion) invoke(cmd string, args, result interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), ion.callTimeout)
+	defer cancel()
+	return ion.call(ctx, cmd, args, result)
+}
+
+// disconnect fails all pending and future calls. Called once the connection
+// to Electron can no longer deliver replies.
+func (ion *Ion) disconnect() {
+	ion.disconnectOnce.Do(func() { close(ion.disconnected) })
+}
+
+func (ion *Ion) deliverReply(r *reply) {
+	ion.pendingLock.Lock()
+	ch, ok := ion.pending[r.ReplyTo]
+	ion.pendingLock.Unlock()
+	if ok {
+		ch <- r
+	} else {
+		ion.logger.Warnf("Discarding reply for unknown request %d", r.ReplyTo)
+	}
+}
+
+func (ion *Ion) handleCommand(cmd string, args json.RawMessage) {
+	if handler, ok := ion.handlers[cmd]; ok {
+		handler(args)
+	} else {
+		ion.logger.Warnf("Ignoring unknown command %s", cmd)
+	}
+}
+
+// ExecuteJavaScript evaluates 'code' in Electron's main process and returns
+// the result. If the result is a promise, it is awaited. The code has access
+// to require() and to the same 'ion' object given to extension modules. If
+// evaluation throws, the returned error will be a *RemoteError with the
+// JavaScript message and stack as its cause.
+func (ion *Ion) ExecuteJavaScript(ctx context.Context, code string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if err := ion.call(ctx, "main.executeJavaScript", &windowArgs{Code: code}, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
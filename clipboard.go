@@ -0,0 +1,94 @@
+package ion
+
+import "github.com/richardwilkes/toolbox/errs"
+
+// ClipboardData holds data to be placed on the clipboard. Any combination of
+// Text, HTML, RTF and PNG may be set, allowing the same content to be offered
+// in several formats at once. Custom holds raw data keyed by MIME type or
+// platform format name. Electron can only write custom data one format at a
+// time, and some platforms discard what was there before each write, so
+// Custom may hold only a single format and may not be combined with the
+// other fields.
+type ClipboardData struct {
+	Text   string            `json:"text,omitempty"`
+	HTML   string            `json:"html,omitempty"`
+	RTF    string            `json:"rtf,omitempty"`
+	PNG    []byte            `json:"png,omitempty"`
+	Custom map[string][]byte `json:"custom,omitempty"`
+}
+
+type clipboardArgs struct {
+	Format string `json:"format,omitempty"`
+}
+
+// WriteClipboard replaces the contents of the clipboard. Use ClearClipboard
+// to empty it.
+func (ion *Ion) WriteClipboard(data *ClipboardData) error {
+	if data == nil {
+		return errs.New("No clipboard data provided")
+	}
+	if len(data.Custom) > 1 {
+		return errs.New("Only one custom clipboard format may be written at a time")
+	}
+	if len(data.Custom) != 0 && (data.Text != "" || data.HTML != "" || data.RTF != "" || len(data.PNG) != 0) {
+		return errs.New("Custom clipboard formats may not be combined with other formats")
+	}
+	return ion.invoke("clipboard.write", data, nil)
+}
+
+// ClearClipboard removes all content from the clipboard.
+func (ion *Ion) ClearClipboard() error {
+	return ion.invoke("clipboard.clear", nil, nil)
+}
+
+// ClipboardFormats returns the formats currently available on the clipboard.
+func (ion *Ion) ClipboardFormats() ([]string, error) {
+	var formats []string
+	if err := ion.invoke("clipboard.formats", nil, &formats); err != nil {
+		return nil, err
+	}
+	return formats, nil
+}
+
+// ReadClipboardText returns the plain text content of the clipboard.
+func (ion *Ion) ReadClipboardText() (string, error) {
+	return ion.readClipboardString("text")
+}
+
+// ReadClipboardHTML returns the HTML content of the clipboard.
+func (ion *Ion) ReadClipboardHTML() (string, error) {
+	return ion.readClipboardString("html")
+}
+
+// ReadClipboardRTF returns the RTF content of the clipboard.
+func (ion *Ion) ReadClipboardRTF() (string, error) {
+	return ion.readClipboardString("rtf")
+}
+
+// ReadClipboardPNG returns the image content of the clipboard, encoded as a
+// PNG. Returns nil if there is no image on the clipboard.
+func (ion *Ion) ReadClipboardPNG() ([]byte, error) {
+	var data []byte
+	if err := ion.invoke("clipboard.readImage", nil, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ReadClipboardBuffer returns the raw content of the clipboard for the given
+// MIME type or platform format name.
+func (ion *Ion) ReadClipboardBuffer(format string) ([]byte, error) {
+	var data []byte
+	if err := ion.invoke("clipboard.readBuffer", &clipboardArgs{Format: format}, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (ion *Ion) readClipboardString(format string) (string, error) {
+	var s string
+	if err := ion.invoke("clipboard.read", &clipboardArgs{Format: format}, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
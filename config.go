@@ -0,0 +1,74 @@
+package ion
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/cmdline"
+)
+
+// electronConfigEnv is the environment variable used to pass the
+// electronConfig to ion.js.
+const electronConfigEnv = "ION_CONFIG"
+
+// appEntryPage is the page within the AppFileSystem loaded by the main
+// window.
+const appEntryPage = "index.html"
+
+// electronConfig holds the settings ion.js needs at launch.
+type electronConfig struct {
+	// AppName replaces Electron's default name, which also determines the
+	// userData directory Electron's single-instance lock is tied to.
+	AppName        string   `json:"appName,omitempty"`
+	URL            string   `json:"url,omitempty"`
+	Scheme         string   `json:"scheme,omitempty"`
+	Extensions     string   `json:"extensions,omitempty"`
+	SingleInstance bool     `json:"singleInstance,omitempty"`
+	URLSchemes     []string `json:"urlSchemes,omitempty"`
+	FileExtensions []string `json:"fileExtensions,omitempty"`
+	ExecPath       string   `json:"execPath,omitempty"`
+}
+
+func (ion *Ion) electronConfig() *electronConfig {
+	config := &electronConfig{
+		AppName:        cmdline.AppCmdName,
+		SingleInstance: ion.singleInstance,
+		URLSchemes:     ion.urlSchemes,
+		FileExtensions: ion.fileExtensions,
+	}
+	if len(ion.urlSchemes) != 0 {
+		// The system must launch our executable, not Electron's, to handle
+		// a URL.
+		if exe, err := os.Executable(); err == nil {
+			config.ExecPath = exe
+		}
+	}
+	if ion.protocolHandler != nil {
+		config.Scheme = ion.protocolScheme
+		config.URL = ion.protocolScheme + "://app/"
+	} else if ion.appFileSystem != nil {
+		config.URL = fileURL(filepath.Join(ion.appPath(), appEntryPage))
+	}
+	if ion.extensionFileSystem != nil {
+		config.Extensions = ion.extensionPath()
+	}
+	return config
+}
+
+func (ion *Ion) appPath() string {
+	return filepath.Join(ion.provisioningPath, "app")
+}
+
+func (ion *Ion) extensionPath() string {
+	return filepath.Join(ion.provisioningPath, "extensions")
+}
+
+func fileURL(path string) string {
+	p := filepath.ToSlash(path)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return (&url.URL{Scheme: "file", Path: p}).String()
+}
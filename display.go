@@ -0,0 +1,43 @@
+package ion
+
+// Point holds a location in screen coordinates.
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Rect holds a rectangle in screen coordinates.
+type Rect struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Display holds information about a display attached to the system.
+type Display struct {
+	ID          int64   `json:"id"`
+	Bounds      Rect    `json:"bounds"`
+	WorkArea    Rect    `json:"workArea"`
+	ScaleFactor float64 `json:"scaleFactor"`
+	// Rotation is the clockwise rotation of the display in degrees: 0, 90,
+	// 180 or 270.
+	Rotation int  `json:"rotation"`
+	Primary  bool `json:"primary"`
+}
+
+// Displays returns the displays currently attached to the system.
+func (ion *Ion) Displays() ([]*Display, error) {
+	var displays []*Display
+	if err := ion.invoke("screen.displays", nil, &displays); err != nil {
+		return nil, err
+	}
+	return displays, nil
+}
+
+// CursorScreenPoint returns the current location of the mouse cursor.
+func (ion *Ion) CursorScreenPoint() (Point, error) {
+	var pt Point
+	err := ion.invoke("screen.cursor", nil, &pt)
+	return pt, err
+}
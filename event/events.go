@@ -1,6 +1,9 @@
 package event
 
-import "strings"
+import (
+	"encoding/json"
+	"strings"
+)
 
 // Event names.
 const (
@@ -8,12 +11,106 @@ const (
 	AppReady = "app.ready"
 	// AppShutdown is send when Electron is shutdown.
 	AppShutdown = "app.shutdown"
+	// AppSecondInstance is sent when a second copy of the application is
+	// launched while the SingleInstance option is in effect. Uses Argv and
+	// WorkingDir.
+	AppSecondInstance = "app.second-instance"
+	// AppOpenURL is sent when the system asks the application to open a URL
+	// with one of the schemes registered via the URLSchemes option, or one
+	// is found on the command line. Uses URL.
+	AppOpenURL = "app.open-url"
+	// AppOpenFile is sent when the system asks the application to open a
+	// file, or one with an extension registered via the FileExtensions
+	// option is found on the command line. Uses Path.
+	AppOpenFile = "app.open-file"
+	// TrayClicked is sent when a tray icon is clicked. Uses TrayID.
+	TrayClicked = "tray.clicked"
+	// TrayDoubleClicked is sent when a tray icon is double-clicked. Uses
+	// TrayID.
+	TrayDoubleClicked = "tray.double-clicked"
+	// TrayRightClicked is sent when a tray icon is right-clicked. Uses
+	// TrayID.
+	TrayRightClicked = "tray.right-clicked"
+	// MenuClicked is sent when a menu item is selected. Uses MenuItemID and,
+	// if the menu belongs to a tray, TrayID.
+	MenuClicked = "menu.clicked"
+	// NotificationShown is sent when a notification is displayed. Uses
+	// NotificationID.
+	NotificationShown = "notification.shown"
+	// NotificationClicked is sent when a notification is clicked. Uses
+	// NotificationID.
+	NotificationClicked = "notification.clicked"
+	// NotificationAction is sent when one of a notification's action buttons
+	// is clicked. Uses NotificationID and ActionIndex.
+	NotificationAction = "notification.action"
+	// NotificationReplied is sent when the user replies to a notification.
+	// Uses NotificationID and Reply.
+	NotificationReplied = "notification.replied"
+	// NotificationClosed is sent when a notification is dismissed. Uses
+	// NotificationID.
+	NotificationClosed = "notification.closed"
+	// DisplayAdded is sent when a display is attached. Uses DisplayID.
+	DisplayAdded = "display.added"
+	// DisplayRemoved is sent when a display is detached. Uses DisplayID.
+	DisplayRemoved = "display.removed"
+	// DisplayMetricsChanged is sent when the bounds, work area, scale factor
+	// or rotation of a display changes. Uses DisplayID and ChangedMetrics.
+	DisplayMetricsChanged = "display.metrics-changed"
+	// GlobalShortcut is sent when a global shortcut is pressed. Uses
+	// Accelerator.
+	GlobalShortcut = "shortcut.global"
+	// WindowShortcut is sent when a window shortcut is pressed. Uses
+	// Accelerator and WindowID.
+	WindowShortcut = "shortcut.window"
+	// PowerSuspend is sent when the system is about to suspend.
+	PowerSuspend = "power.suspend"
+	// PowerResume is sent when the system has resumed from suspension.
+	PowerResume = "power.resume"
+	// PowerOnBattery is sent when the system switches to battery power.
+	PowerOnBattery = "power.on-battery"
+	// PowerOnAC is sent when the system switches to AC power.
+	PowerOnAC = "power.on-ac"
+	// PowerLockScreen is sent when the screen is locked.
+	PowerLockScreen = "power.lock-screen"
+	// PowerUnlockScreen is sent when the screen is unlocked.
+	PowerUnlockScreen = "power.unlock-screen"
+	// PowerShutdown is sent when the system is about to shut down or reboot.
+	PowerShutdown = "power.shutdown"
+	// AppearanceChanged is sent when the system switches between light and
+	// dark mode or high contrast is turned on or off. Uses DarkMode and
+	// HighContrast.
+	AppearanceChanged = "appearance.changed"
+	// WindowFilesDropped is sent when files are dropped onto a window. Uses
+	// WindowID, Paths, X and Y, with the coordinates relative to the window's
+	// content.
+	WindowFilesDropped = "window.files-dropped"
 )
 
 // Event is a union of all event types. All events fill out the Name field.
 // Events that use other fields will note their usage in their descriptions.
+// Events emitted by extension modules may carry arbitrary data in the Data
+// field.
 type Event struct {
-	Name string `json:"name"`
+	Name           string          `json:"name"`
+	TrayID         int64           `json:"trayID,omitempty"`
+	MenuItemID     string          `json:"menuItemID,omitempty"`
+	NotificationID int64           `json:"notificationID,omitempty"`
+	ActionIndex    int             `json:"actionIndex,omitempty"`
+	Reply          string          `json:"reply,omitempty"`
+	DisplayID      int64           `json:"displayID,omitempty"`
+	ChangedMetrics []string        `json:"changedMetrics,omitempty"`
+	Accelerator    string          `json:"accelerator,omitempty"`
+	WindowID       int64           `json:"windowID,omitempty"`
+	Argv           []string        `json:"argv,omitempty"`
+	WorkingDir     string          `json:"workingDir,omitempty"`
+	URL            string          `json:"url,omitempty"`
+	Path           string          `json:"path,omitempty"`
+	DarkMode       bool            `json:"darkMode,omitempty"`
+	HighContrast   bool            `json:"highContrast,omitempty"`
+	Paths          []string        `json:"paths,omitempty"`
+	X              int             `json:"x,omitempty"`
+	Y              int             `json:"y,omitempty"`
+	Data           json.RawMessage `json:"data,omitempty"`
 }
 
 func (e Event) String() string {
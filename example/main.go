@@ -15,7 +15,7 @@ func main() {
 		ion.Logger(&jot.Logger{}),
 		ion.MacOSAppBundleID("com.trollworks.ion_example"),
 		ion.ProvisioningPath("support"),
-		ion.ElectronArchiveRetriever(provisioner.FileSystemArchiveRetriever(fs, "/"+provisioner.ElectronArchiveName())),
+		ion.ElectronStreamingArchiveRetriever(provisioner.FileSystemStreamingArchiveRetriever(fs, "/"+provisioner.ElectronArchiveName())),
 		ion.IconFileSystem(fs),
 	)
 	jot.FatalIfErr(err)
@@ -0,0 +1,26 @@
+package ion
+
+import (
+	"io/ioutil"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// loadIcon retrieves the bytes of an icon file from the file system set via
+// the IconFileSystem option.
+func (ion *Ion) loadIcon(path string) ([]byte, error) {
+	if ion.iconFileSystem == nil {
+		return nil, errs.New("no icon file system has been set")
+	}
+	f, err := ion.iconFileSystem.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer xio.CloseIgnoringErrors(f)
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, errs.NewfWithCause(err, "Failed to load %s", path)
+	}
+	return data, nil
+}
@@ -23,7 +23,11 @@ import (
 	"github.com/richardwilkes/toolbox/xio"
 )
 
-const ionFSVersion = "1"
+const (
+	ionFSVersion        = "26"
+	defaultCallTimeout  = 30 * time.Second
+	instanceLockTimeout = 5 * time.Minute
+)
 
 //go:generate mkembeddedfs --no-modtime --output ionfs_gen.go --pkg ion --name ionfs --strip ionfs ionfs
 
@@ -32,8 +36,27 @@ type Ion struct {
 	provisioningPath         string
 	macOSAppBundleID         string
 	logger                   logadapter.Logger
-	electronArchiveRetriever provisioner.ArchiveRetriever
+	electronArchiveRetriever provisioner.StreamingArchiveRetriever
+	progress                 provisioner.ProgressReporter
+	electronDownloader       *provisioner.Downloader
+	electronMirror           string
+	electronRelease          provisioner.ElectronRelease
+	verification             provisioner.VerificationMode
+	electronArchiveCache     *provisioner.ArchiveCache
+	disableElectronCache     bool
 	iconFileSystem           http.FileSystem
+	appFileSystem            http.FileSystem
+	appVersion               string
+	extensionFileSystem      http.FileSystem
+	extensionVersion         string
+	singleInstance           bool
+	urlSchemes               []string
+	fileExtensions           []string
+	instanceLock             *provisioner.FileLock
+	instanceLockOnce         sync.Once
+	protocolScheme           string
+	protocolHandler          http.Handler
+	externalURLSchemes       []string
 	dispatcher               *event.Dispatcher
 	tcpListener              net.Listener
 	ctx                      context.Context
@@ -42,13 +65,33 @@ type Ion struct {
 	shutdownOnce             sync.Once
 	connLock                 sync.RWMutex
 	conn                     net.Conn
+	connected                chan struct{}
+	disconnected             chan struct{}
+	disconnectOnce           sync.Once
+	callTimeout              time.Duration
+	pendingLock              sync.Mutex
+	pending                  map[int64]chan *reply
+	handlers                 map[string]func(args json.RawMessage)
+	lastID                   int64
+	shortcutLock             sync.Mutex
+	shortcuts                map[int64]map[string]bool
 }
 
 // New creates a new Ion instance, launching Electron.
 func New(options ...Option) (*Ion, error) {
+	return NewWithContext(context.Background(), options...)
+}
+
+// NewWithContext creates a new Ion instance, launching Electron. Cancelling
+// 'ctx' aborts provisioning, which may involve a lengthy download.
+func NewWithContext(ctx context.Context, options ...Option) (*Ion, error) {
 	var err error
 	ion := &Ion{
 		shutdownChan: make(chan bool),
+		connected:    make(chan struct{}),
+		disconnected: make(chan struct{}),
+		pending:      make(map[int64]chan *reply),
+		shortcuts:    make(map[int64]map[string]bool),
 	}
 	for _, option := range options {
 		option(ion)
@@ -65,20 +108,75 @@ func New(options ...Option) (*Ion, error) {
 	if ion.logger == nil {
 		ion.logger = &logadapter.Discarder{}
 	}
-	if err = provisioner.ProvisionElectron(ion.provisioningPath, ion.macOSAppBundleID, ion.iconFileSystem, ion.electronArchiveRetriever); err != nil {
+	if ion.callTimeout <= 0 {
+		ion.callTimeout = defaultCallTimeout
+	}
+	if ion.singleInstance {
+		// Held until Electron has taken its own single-instance lock, so that
+		// a second launch waits rather than provisioning concurrently. The
+		// wait is bounded, so a first instance that has hung cannot hang
+		// every later launch too.
+		lockCtx, cancel := context.WithTimeout(ctx, instanceLockTimeout)
+		ion.instanceLock, err = provisioner.Lock(lockCtx, filepath.Join(ion.provisioningPath, "instance.lock"))
+		cancel()
+		if err != nil {
+			return nil, errs.NewWithCause("Gave up waiting for another instance to start", err)
+		}
+		defer func() {
+			if err != nil {
+				ion.releaseInstanceLock()
+			}
+		}()
+	}
+	if err = provisioner.ProvisionElectron(ctx, ion.provisioningPath, &provisioner.ElectronOptions{
+		MacOSAppBundleID: ion.macOSAppBundleID,
+		IconFS:           ion.iconFileSystem,
+		Associations: &provisioner.Associations{
+			URLSchemes:     ion.urlSchemes,
+			FileExtensions: ion.fileExtensions,
+		},
+		ArchiveRetriever: ion.electronArchiveRetriever,
+		Downloader:       ion.electronDownloader,
+		Mirror:           ion.electronMirror,
+		Progress:         ion.progress,
+		Release:          ion.electronRelease,
+		Verification:     ion.verification,
+		Logger:           ion.logger,
+		Cache:            ion.electronArchiveCache,
+		DisableCache:     ion.disableElectronCache,
+	}); err != nil {
 		return nil, err
 	}
-	if err = provisioner.FromFileSystem(ionFSVersion, "/", filepath.Join(ion.provisioningPath, "ion"), ionfs.FileSystem("ionfs"), nil); err != nil {
+	deployOptions := &provisioner.DeployOptions{
+		Verification: ion.verification,
+		Logger:       ion.logger,
+		Context:      ctx,
+	}
+	if err = provisioner.FromFileSystemWithOptions(ionFSVersion, "/", filepath.Join(ion.provisioningPath, "ion"), ionfs.FileSystem("ionfs"), nil, deployOptions); err != nil {
 		return nil, err
 	}
+	if ion.appFileSystem != nil {
+		if err = provisioner.FromFileSystemWithOptions(ion.appVersion, "/", ion.appPath(), ion.appFileSystem, nil, deployOptions); err != nil {
+			return nil, err
+		}
+	}
+	if ion.extensionFileSystem != nil {
+		if err = provisioner.FromFileSystemWithOptions(ion.extensionVersion, "/", ion.extensionPath(), ion.extensionFileSystem, nil, deployOptions); err != nil {
+			return nil, err
+		}
+	}
 	ion.dispatcher = event.NewDispatcher(ion.logger)
+	ion.handlers = map[string]func(args json.RawMessage){
+		"protocol.request":      ion.handleProtocolRequest,
+		"shortcut.windowClosed": ion.handleWindowClosed,
+	}
+	ion.ctx, ion.cancel = context.WithCancel(context.Background())
 	atexit.Register(ion.Shutdown)
 	return ion, nil
 }
 
 // Start Ion.
 func (ion *Ion) Start() error {
-	ion.ctx, ion.cancel = context.WithCancel(context.Background())
 	var err error
 	if ion.tcpListener, err = net.Listen("tcp", "127.0.0.1:"); err != nil {
 		return errs.Wrap(err)
@@ -88,16 +186,25 @@ func (ion *Ion) Start() error {
 	go ion.waitForElectron(accepted)
 	if err = ion.startElectron(ion.tcpListener.Addr().String()); err != nil {
 		ion.cancel()
+		ion.releaseInstanceLock()
 		return errs.Wrap(err)
 	}
 	return nil
 }
 
 func (ion *Ion) startElectron(addr string) error {
-	cmd := exec.CommandContext(ion.ctx, provisioner.ElectronExecutablePath(ion.provisioningPath), filepath.Join(ion.provisioningPath, "ion/ion.js"), addr)
+	config, err := json.Marshal(ion.electronConfig())
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	// Everything after "--" is our own command line, which Electron will not
+	// interpret.
+	args := append([]string{filepath.Join(ion.provisioningPath, "ion/ion.js"), addr, "--"}, os.Args[1:]...)
+	cmd := exec.CommandContext(ion.ctx, ion.electronRelease.ExecutablePath(ion.provisioningPath), args...)
+	cmd.Env = append(os.Environ(), electronConfigEnv+"="+string(config))
 	cmd.Stderr = xio.NewLineWriter(func(data []byte) { ion.logger.Error(provisioner.ElectronName, " stderr: ", string(data)) })
 	cmd.Stdout = xio.NewLineWriter(func(data []byte) { ion.logger.Info(provisioner.ElectronName, " stdout: ", string(data)) })
-	if err := cmd.Start(); err != nil {
+	if err = cmd.Start(); err != nil {
 		return errs.Wrap(err)
 	}
 	go ion.watchElectron(cmd)
@@ -137,6 +244,8 @@ func (ion *Ion) waitForElectron(accepted chan bool) {
 	ion.connLock.Lock()
 	ion.conn = conn
 	ion.connLock.Unlock()
+	close(ion.connected)
+	ion.releaseInstanceLock()
 	ion.close(ion.tcpListener)
 	ion.tcpListener = nil
 	go ion.receiver(conn)
@@ -148,6 +257,10 @@ func (ion *Ion) Dispatcher() *event.Dispatcher {
 }
 
 func (ion *Ion) receiver(r io.Reader) {
+	// Pending calls are failed before shutting down, as event listeners run
+	// during shutdown may be waiting on replies that can no longer arrive.
+	defer ion.Shutdown()
+	defer ion.disconnect()
 	bufferedReader := bufio.NewReader(r)
 	for {
 		if ion.ctx.Err() != nil {
@@ -156,14 +269,27 @@ func (ion *Ion) receiver(r io.Reader) {
 		buffer, err := bufferedReader.ReadBytes('\n')
 		if err != nil {
 			// "wsarecv" is the error sent on Windows when the client closes its connection
-			if err == io.EOF || strings.Contains(strings.ToLower(err.Error()), "wsarecv:") {
-				ion.Shutdown()
-				return
+			if err != io.EOF && !strings.Contains(strings.ToLower(err.Error()), "wsarecv:") && ion.ctx.Err() == nil {
+				ion.logger.Error(errs.Wrap(err))
 			}
-			ion.logger.Error(errs.Wrap(err))
+			return
+		}
+		buffer = bytes.TrimSpace(buffer)
+		var msg incoming
+		if err = json.Unmarshal(buffer, &msg); err != nil {
+			ion.logger.Error(errs.NewWithCause("Invalid message data", err))
+			continue
+		}
+		if msg.ReplyTo != 0 {
+			ion.deliverReply(&msg.reply)
+			continue
+		}
+		if msg.Cmd != "" {
+			go ion.handleCommand(msg.Cmd, msg.Args)
+			continue
 		}
 		var e event.Event
-		if err = json.Unmarshal(bytes.TrimSpace(buffer), &e); err != nil {
+		if err = json.Unmarshal(buffer, &e); err != nil {
 			ion.logger.Error(errs.NewWithCause("Invalid event data", err))
 		} else {
 			ion.dispatcher.Dispatch(&e)
@@ -179,6 +305,9 @@ func (ion *Ion) send(data interface{}) error {
 	d = append(d, '\n')
 	ion.connLock.RLock()
 	defer ion.connLock.RUnlock()
+	if ion.conn == nil {
+		return errs.New("not connected to " + provisioner.ElectronName)
+	}
 	if _, err = ion.conn.Write(d); err != nil {
 		return errs.Wrap(err)
 	}
@@ -192,8 +321,16 @@ func (ion *Ion) Shutdown() {
 }
 
 func (ion *Ion) shutdown() {
+	select {
+	case <-ion.connected:
+	default:
+		// Electron never connected, so nothing waiting on it can proceed.
+		ion.disconnect()
+	}
 	ion.dispatcher.Dispatch(&event.Event{Name: event.AppShutdown})
 	ion.dispatcher.Shutdown()
+	ion.unregisterAllShortcuts()
+	ion.releaseInstanceLock()
 	if ion.cancel != nil {
 		ion.cancel()
 	}
@@ -206,6 +343,16 @@ func (ion *Ion) shutdown() {
 	close(ion.shutdownChan)
 }
 
+func (ion *Ion) releaseInstanceLock() {
+	ion.instanceLockOnce.Do(func() {
+		if ion.instanceLock != nil {
+			if err := ion.instanceLock.Unlock(); err != nil {
+				ion.logger.Error(err)
+			}
+		}
+	})
+}
+
 func (ion *Ion) close(closer io.Closer) {
 	if closer != nil {
 		if err := closer.Close(); err != nil {
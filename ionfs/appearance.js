@@ -0,0 +1,69 @@
+const { app } = require('electron')
+
+module.exports = (ion) => {
+  // These modules may not be used until the app is ready. nativeTheme is not
+  // present in older versions of Electron, in which case systemPreferences
+  // provides what it can.
+  const nativeTheme = () => require('electron').nativeTheme; // eslint-disable-line global-require
+  const systemPreferences = () => require('electron').systemPreferences; // eslint-disable-line global-require
+
+  const current = () => {
+    if (nativeTheme()) {
+      return {
+        darkMode: nativeTheme().shouldUseDarkColors,
+        highContrast: nativeTheme().shouldUseHighContrastColors,
+      };
+    }
+    const prefs = systemPreferences();
+    return {
+      darkMode: prefs.isDarkMode ? prefs.isDarkMode() : false,
+      highContrast: prefs.isHighContrastColorScheme ? prefs.isHighContrastColorScheme() : false,
+    };
+  };
+
+  let last = null;
+  const changed = () => {
+    const appearance = current();
+    if (last === null || last.darkMode !== appearance.darkMode
+      || last.highContrast !== appearance.highContrast) {
+      last = appearance;
+      ion.emit('appearance.changed', appearance);
+    }
+  };
+
+  app.on('ready', () => {
+    last = current();
+    if (nativeTheme()) {
+      nativeTheme().on('updated', changed);
+      return;
+    }
+    const prefs = systemPreferences();
+    if (process.platform === 'darwin') {
+      prefs.subscribeNotification('AppleInterfaceThemeChangedNotification', changed);
+    } else if (process.platform === 'win32') {
+      prefs.on('inverted-color-scheme-changed', changed);
+      prefs.on('high-contrast-color-scheme-changed', changed);
+    }
+  });
+
+  ion.commands['appearance.get'] = () => current();
+
+  ion.commands['appearance.setThemeSource'] = (args) => {
+    if (!['system', 'light', 'dark'].includes(args.source)) {
+      throw new Error(`invalid theme source: ${args.source}`);
+    }
+    if (nativeTheme()) {
+      nativeTheme().themeSource = args.source;
+      return;
+    }
+    // Before nativeTheme, macOS could still override the appearance of the
+    // app as a whole.
+    const prefs = systemPreferences();
+    if (process.platform === 'darwin' && prefs.setAppLevelAppearance) {
+      prefs.setAppLevelAppearance(args.source === 'system' ? null : args.source);
+      changed();
+      return;
+    }
+    throw new Error(`setting the theme source requires Electron 7 or later; this is Electron ${process.versions.electron}`);
+  };
+};
@@ -0,0 +1,56 @@
+const { clipboard, nativeImage } = require('electron')
+
+module.exports = (ion) => {
+  ion.commands['clipboard.write'] = (args) => {
+    const data = {};
+    if (args.text !== undefined) {
+      data.text = args.text;
+    }
+    if (args.html !== undefined) {
+      data.html = args.html;
+    }
+    if (args.rtf !== undefined) {
+      data.rtf = args.rtf;
+    }
+    if (args.png !== undefined) {
+      data.image = nativeImage.createFromBuffer(Buffer.from(args.png, 'base64'));
+    }
+    // writeBuffer replaces the clipboard contents on some platforms, so it
+    // cannot be combined with anything else.
+    const custom = Object.keys(args.custom || {});
+    if (custom.length === 0) {
+      clipboard.write(data);
+      return;
+    }
+    if (custom.length > 1 || Object.keys(data).length !== 0) {
+      throw new Error('a custom clipboard format must be written on its own');
+    }
+    clipboard.writeBuffer(custom[0], Buffer.from(args.custom[custom[0]], 'base64'));
+  };
+
+  ion.commands['clipboard.clear'] = () => {
+    clipboard.clear();
+  };
+
+  ion.commands['clipboard.formats'] = () => clipboard.availableFormats();
+
+  ion.commands['clipboard.read'] = (args) => {
+    switch (args.format) {
+      case 'text':
+        return clipboard.readText();
+      case 'html':
+        return clipboard.readHTML();
+      case 'rtf':
+        return clipboard.readRTF();
+      default:
+        throw new Error(`unknown clipboard format: ${args.format}`);
+    }
+  };
+
+  ion.commands['clipboard.readImage'] = () => {
+    const image = clipboard.readImage();
+    return image.isEmpty() ? null : image.toPNG().toString('base64');
+  };
+
+  ion.commands['clipboard.readBuffer'] = (args) => clipboard.readBuffer(args.format).toString('base64');
+};
@@ -0,0 +1,27 @@
+const { BrowserWindow, ipcMain, nativeImage } = require('electron')
+
+module.exports = (ion) => {
+  ipcMain.on('ion.files-dropped', (event, data) => {
+    const win = BrowserWindow.fromWebContents(event.sender);
+    if (win !== null) {
+      ion.emit('window.files-dropped', {
+        windowID: win.id,
+        paths: data.paths,
+        x: Math.round(data.x),
+        y: Math.round(data.y),
+      });
+    }
+  });
+
+  ion.commands['window.startDrag'] = (args) => {
+    const win = BrowserWindow.fromId(args.id);
+    if (win === null) {
+      throw new Error(`unknown window: ${args.id}`);
+    }
+    win.webContents.startDrag({
+      file: args.paths[0],
+      files: args.paths,
+      icon: nativeImage.createFromBuffer(Buffer.from(args.icon, 'base64')),
+    });
+  };
+};
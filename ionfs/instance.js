@@ -0,0 +1,26 @@
+const { app } = require('electron')
+
+// Enforces a single running instance, forwarding the command line of any
+// later launch to this one.
+module.exports = (ion) => {
+  if (!ion.config.singleInstance) {
+    return;
+  }
+
+  const forward = (argv, workingDir) => {
+    // Go places its own arguments after '--'.
+    const i = argv.indexOf('--');
+    ion.emit('app.second-instance', { argv: i >= 0 ? argv.slice(i + 1) : [], workingDir });
+    ion.deliverArgs(argv, workingDir);
+  };
+
+  if (app.requestSingleInstanceLock) {
+    if (!app.requestSingleInstanceLock()) {
+      app.exit(0);
+      return;
+    }
+    app.on('second-instance', (event, argv, workingDir) => forward(argv, workingDir));
+  } else if (app.makeSingleInstance(forward)) {
+    app.exit(0);
+  }
+};
@@ -1,23 +1,166 @@
 const { app, BrowserWindow } = require('electron')
+const fs = require('fs')
+const net = require('net')
+const path = require('path')
 
 // Handle creating/removing shortcuts on Windows when installing/uninstalling.
 // if (require('electron-squirrel-startup')) { // eslint-disable-line global-require
 //   app.quit();
 // }
 
+// The connection back to Go. Messages in both directions are JSON objects,
+// one per line. Go sends commands ({id, cmd, args}), which are answered with
+// replies ({replyTo, result} or {replyTo, error}) unless the id is omitted.
+// Events ({name, ...}) are sent to Go unsolicited, as are commands
+// ({cmd, args}) that Go handles without replying.
+let conn = null;
+const outbox = [];
+
 // Keep a global reference of the window object, if you don't, the window will
 // be closed automatically when the JavaScript object is garbage collected.
-let mainWindow;
+// It is declared ahead of 'ion' because extensions may call ion.mainWindow()
+// while they are being loaded.
+let mainWindow = null;
+
+const ion = {
+  // Settings passed from Go at launch.
+  config: JSON.parse(process.env.ION_CONFIG || '{}'),
+
+  // Command handlers, keyed by command name. Each is called with the
+  // command's arguments and may return a value or a promise.
+  commands: {},
+
+  send: (msg) => {
+    const line = `${JSON.stringify(msg)}\n`;
+    if (conn === null) {
+      outbox.push(line);
+    } else {
+      conn.write(line);
+    }
+  },
+
+  emit: (name, fields) => {
+    ion.send(Object.assign({ name }, fields));
+  },
+
+  // The preload script that reports dropped files. Windows created by
+  // extensions should include it in their webPreferences to get the same
+  // behavior.
+  preload: path.join(__dirname, 'preload.js'),
+
+  // Returns the main window, or null if it has been closed.
+  mainWindow: () => mainWindow,
+};
+
+const replyWithError = (msg, err) => {
+  const error = err instanceof Error ? err : new Error(String(err));
+  if (msg.id) {
+    ion.send({ replyTo: msg.id, error: { message: error.message, stack: error.stack } });
+  } else {
+    console.error(error);
+  }
+};
+
+const handleCommand = (msg) => {
+  Promise.resolve()
+    .then(() => {
+      const command = ion.commands[msg.cmd];
+      if (command === undefined) {
+        throw new Error(`unknown command: ${msg.cmd}`);
+      }
+      return command(msg.args || {});
+    })
+    .then((result) => {
+      if (msg.id) {
+        // Results that cannot be serialized, such as those with cycles or
+        // BigInts, are reported as errors so that Go is not left waiting.
+        try {
+          ion.send({ replyTo: msg.id, result: result === undefined ? null : result });
+        } catch (err) {
+          replyWithError(msg, new Error(`unable to send result of ${msg.cmd}: ${err.message}`));
+        }
+      }
+    }, (err) => replyWithError(msg, err))
+    .catch((err) => console.error(err));
+};
+
+const connect = () => {
+  const addr = process.argv[2];
+  const sep = addr.lastIndexOf(':');
+  const socket = net.connect(Number(addr.substring(sep + 1)), addr.substring(0, sep));
+  let buffer = '';
+  socket.setEncoding('utf8');
+  socket.on('connect', () => {
+    conn = socket;
+    // Anything queued before the connection was made is delivered after
+    // app.ready.
+    ion.emit('app.ready');
+    outbox.splice(0).forEach((line) => conn.write(line));
+  });
+  socket.on('data', (chunk) => {
+    buffer += chunk;
+    let i = buffer.indexOf('\n');
+    while (i >= 0) {
+      const line = buffer.substring(0, i).trim();
+      buffer = buffer.substring(i + 1);
+      if (line.length > 0) {
+        handleCommand(JSON.parse(line));
+      }
+      i = buffer.indexOf('\n');
+    }
+  });
+  socket.on('error', (err) => {
+    console.error(err);
+  });
+  socket.on('close', () => {
+    conn = null;
+    app.quit();
+  });
+};
+
+// Without a name of its own, every application would run as "Electron" and
+// share one userData directory, and with it the single-instance lock.
+if (ion.config.appName) {
+  app.setName(ion.config.appName);
+  app.setPath('userData', path.join(app.getPath('appData'), ion.config.appName));
+}
+
+require('./open')(ion);
+require('./instance')(ion);
+require('./tray')(ion);
+require('./notification')(ion);
+require('./clipboard')(ion);
+require('./shell')(ion);
+require('./screen')(ion);
+require('./protocol')(ion);
+require('./window')(ion);
+require('./shortcut')(ion);
+require('./power')(ion);
+require('./appearance')(ion);
+require('./drop')(ion);
+
+// Load any extension modules supplied from Go.
+if (ion.config.extensions) {
+  fs.readdirSync(ion.config.extensions)
+    .filter((name) => name.endsWith('.js'))
+    .sort()
+    .forEach((name) => {
+      require(path.join(ion.config.extensions, name))(ion); // eslint-disable-line global-require, import/no-dynamic-require
+    });
+}
 
 const createWindow = () => {
   // Create the browser window.
   mainWindow = new BrowserWindow({
     width: 800,
     height: 600,
+    webPreferences: {
+      preload: ion.preload,
+    },
   });
 
   // and load the index.html of the app.
-  mainWindow.loadURL(`file://${__dirname}/index.html`);
+  mainWindow.loadURL(ion.config.url || `file://${__dirname}/index.html`);
 
   // Open the DevTools.
 //   mainWindow.webContents.openDevTools();
@@ -34,7 +177,10 @@ const createWindow = () => {
 // This method will be called when Electron has finished
 // initialization and is ready to create browser windows.
 // Some APIs can only be used after this event occurs.
-app.on('ready', createWindow);
+app.on('ready', () => {
+  createWindow();
+  connect();
+});
 
 // Quit when all windows are closed.
 app.on('window-all-closed', () => {
@@ -0,0 +1,25 @@
+const { Menu } = require('electron')
+
+// Builds an Electron menu from the Go MenuItem description. 'clicked' is
+// called with the item's ID when an item is selected.
+const buildTemplate = (items, clicked) => items.map((item) => {
+  const entry = {
+    id: item.id,
+    type: item.type || 'normal',
+    label: item.label,
+    accelerator: item.accelerator,
+    enabled: !item.disabled,
+    checked: !!item.checked,
+  };
+  if (item.submenu) {
+    entry.type = 'submenu';
+    entry.submenu = buildTemplate(item.submenu, clicked);
+  } else if (entry.type !== 'separator') {
+    entry.click = () => clicked(item.id);
+  }
+  return entry;
+});
+
+module.exports = {
+  build: (items, clicked) => Menu.buildFromTemplate(buildTemplate(items || [], clicked)),
+};
@@ -0,0 +1,42 @@
+const { Notification, nativeImage } = require('electron')
+
+module.exports = (ion) => {
+  const notifications = new Map();
+
+  ion.commands['notification.show'] = (args) => {
+    if (!Notification.isSupported()) {
+      throw new Error('notifications are not supported on this system');
+    }
+    const options = {
+      title: args.title || '',
+      body: args.body || '',
+      silent: !!args.silent,
+      hasReply: !!args.hasReply,
+      actions: (args.actions || []).map((text) => ({ type: 'button', text })),
+    };
+    if (args.icon) {
+      options.icon = nativeImage.createFromBuffer(Buffer.from(args.icon, 'base64'));
+    }
+    const notificationID = args.id;
+    const notification = new Notification(options);
+    notification.on('show', () => ion.emit('notification.shown', { notificationID }));
+    notification.on('click', () => ion.emit('notification.clicked', { notificationID }));
+    notification.on('action', (event, actionIndex) => {
+      ion.emit('notification.action', { notificationID, actionIndex });
+    });
+    notification.on('reply', (event, reply) => ion.emit('notification.replied', { notificationID, reply }));
+    notification.on('close', () => {
+      notifications.delete(notificationID);
+      ion.emit('notification.closed', { notificationID });
+    });
+    notifications.set(notificationID, notification);
+    notification.show();
+  };
+
+  ion.commands['notification.close'] = (args) => {
+    const notification = notifications.get(args.id);
+    if (notification !== undefined) {
+      notification.close();
+    }
+  };
+};
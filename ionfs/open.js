@@ -0,0 +1,43 @@
+const { app } = require('electron')
+const path = require('path')
+
+// Delivers URLs and files the system asks us to open, whether via events or
+// the command line. Anything arriving before the connection to Go is up is
+// queued by ion.send.
+module.exports = (ion) => {
+  const schemes = (ion.config.urlSchemes || []).map((scheme) => `${scheme.toLowerCase()}:`);
+  const extensions = (ion.config.fileExtensions || []).map((ext) => `.${ext.toLowerCase()}`);
+
+  // Go places its own arguments after '--'.
+  ion.deliverArgs = (argv, workingDir) => {
+    const i = argv.indexOf('--');
+    (i >= 0 ? argv.slice(i + 1) : []).forEach((arg) => {
+      const lower = arg.toLowerCase();
+      if (schemes.some((scheme) => lower.startsWith(scheme))) {
+        ion.emit('app.open-url', { url: arg });
+      } else if (extensions.some((ext) => lower.endsWith(ext))) {
+        ion.emit('app.open-file', { path: path.resolve(workingDir, arg) });
+      }
+    });
+  };
+
+  app.on('open-url', (event, url) => {
+    event.preventDefault();
+    ion.emit('app.open-url', { url });
+  });
+
+  app.on('open-file', (event, file) => {
+    event.preventDefault();
+    ion.emit('app.open-file', { path: file });
+  });
+
+  (ion.config.urlSchemes || []).forEach((scheme) => {
+    if (ion.config.execPath) {
+      app.setAsDefaultProtocolClient(scheme, ion.config.execPath, []);
+    } else {
+      app.setAsDefaultProtocolClient(scheme);
+    }
+  });
+
+  ion.deliverArgs(process.argv, process.cwd());
+};
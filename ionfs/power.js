@@ -0,0 +1,36 @@
+const { app } = require('electron')
+
+const events = [
+  'suspend',
+  'resume',
+  'on-battery',
+  'on-ac',
+  'lock-screen',
+  'unlock-screen',
+  'shutdown',
+];
+
+module.exports = (ion) => {
+  // The powerMonitor module may not be used until the app is ready.
+  const powerMonitor = () => require('electron').powerMonitor; // eslint-disable-line global-require
+
+  app.on('ready', () => {
+    events.forEach((name) => {
+      powerMonitor().on(name, () => ion.emit(`power.${name}`));
+    });
+  });
+
+  ion.commands['power.idleTime'] = () => {
+    if (powerMonitor().getSystemIdleTime) {
+      return powerMonitor().getSystemIdleTime();
+    }
+    return new Promise((resolve) => powerMonitor().querySystemIdleTime(resolve));
+  };
+
+  ion.commands['power.idleState'] = (args) => {
+    if (powerMonitor().getSystemIdleState) {
+      return powerMonitor().getSystemIdleState(args.threshold || 0);
+    }
+    return new Promise((resolve) => powerMonitor().querySystemIdleState(args.threshold || 0, resolve));
+  };
+};
@@ -0,0 +1,17 @@
+const { ipcRenderer, webUtils } = require('electron')
+
+// Reports files dropped onto the page to the main process, which forwards
+// them to Go with their real paths.
+const filePath = (file) => (webUtils ? webUtils.getPathForFile(file) : file.path);
+
+window.addEventListener('dragover', (event) => {
+  event.preventDefault();
+});
+
+window.addEventListener('drop', (event) => {
+  event.preventDefault();
+  const paths = Array.from(event.dataTransfer.files).map(filePath).filter((p) => p);
+  if (paths.length > 0) {
+    ipcRenderer.send('ion.files-dropped', { paths, x: event.clientX, y: event.clientY });
+  }
+});
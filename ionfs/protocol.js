@@ -0,0 +1,161 @@
+const { app, protocol, session } = require('electron')
+const fs = require('fs')
+const { PassThrough } = require('stream')
+
+// Returns a promise of the content of one element of a request's uploadData.
+// Electron has used both 'file' and 'filePath' for the path of a file.
+const readUploadData = (data) => {
+  if (data.bytes) {
+    return Promise.resolve(data.bytes);
+  }
+  const file = data.file || data.filePath;
+  if (file) {
+    return new Promise((resolve, reject) => {
+      fs.readFile(file, (err, content) => {
+        if (err) {
+          reject(err);
+        } else if (data.offset || data.length > 0) {
+          const start = data.offset || 0;
+          resolve(content.slice(start, data.length > 0 ? start + data.length : undefined));
+        } else {
+          resolve(content);
+        }
+      });
+    });
+  }
+  if (data.blobUUID) {
+    // Older versions of Electron take a callback rather than returning a
+    // promise.
+    return new Promise((resolve, reject) => {
+      const result = session.defaultSession.getBlobData(data.blobUUID, resolve);
+      if (result && result.then) {
+        result.then(resolve, reject);
+      }
+    });
+  }
+  const err = new Error('unsupported upload data');
+  err.statusCode = 501;
+  return Promise.reject(err);
+};
+
+// Completes a request that could not be relayed to Go.
+const fail = (callback, statusCode) => {
+  const stream = new PassThrough();
+  stream.end();
+  callback({ statusCode, headers: {}, data: stream });
+};
+
+// Serves requests made to the configured scheme by relaying them to Go.
+module.exports = (ion) => {
+  const { scheme } = ion.config;
+  if (!scheme) {
+    return;
+  }
+
+  // Privileged schemes must be registered before the app is ready.
+  if (protocol.registerSchemesAsPrivileged) {
+    protocol.registerSchemesAsPrivileged([{
+      scheme,
+      privileges: {
+        standard: true,
+        secure: true,
+        supportFetchAPI: true,
+        corsEnabled: true,
+        stream: true,
+      },
+    }]);
+  } else {
+    protocol.registerStandardSchemes([scheme], { secure: true });
+  }
+
+  const responses = new Map();
+  let lastID = 0;
+
+  const lookup = (id) => {
+    const response = responses.get(id);
+    if (response === undefined) {
+      throw new Error(`unknown protocol request: ${id}`);
+    }
+    return response;
+  };
+
+  app.on('ready', () => {
+    protocol.registerStreamProtocol(scheme, (request, callback) => {
+      lastID += 1;
+      const id = lastID;
+      // The body is read in full before the request is relayed, so that Go
+      // never sees a partial one.
+      Promise.all((request.uploadData || []).map(readUploadData)).then((parts) => {
+        responses.set(id, { callback, stream: null });
+        ion.send({
+          cmd: 'protocol.request',
+          args: {
+            id,
+            method: request.method,
+            url: request.url,
+            headers: request.headers,
+            body: Buffer.concat(parts).toString('base64'),
+          },
+        });
+      }, (err) => {
+        console.error(err);
+        fail(callback, err.statusCode || 500);
+      });
+    }, (err) => {
+      if (err) {
+        console.error(err);
+      }
+    });
+  });
+
+  ion.commands['protocol.respond'] = (args) => {
+    const response = lookup(args.id);
+    response.stream = new PassThrough();
+    response.callback({ statusCode: args.status, headers: args.headers || {}, data: response.stream });
+  };
+
+  // Waits for the data to be accepted, so that Go does not outpace the
+  // consumer. Fails if the stream closes first, such as when the page making
+  // the request goes away, so that Go stops writing.
+  ion.commands['protocol.write'] = (args) => {
+    const { stream } = lookup(args.id);
+    if (stream.destroyed) {
+      throw new Error(`protocol request ${args.id} was closed`);
+    }
+    if (stream.write(Buffer.from(args.data || '', 'base64'))) {
+      return null;
+    }
+    return new Promise((resolve, reject) => {
+      const onClose = () => done(new Error(`protocol request ${args.id} was closed`));
+      // Called without an argument on 'drain', and with one on 'error'.
+      const done = (err) => {
+        stream.removeListener('drain', done);
+        stream.removeListener('error', done);
+        stream.removeListener('close', onClose);
+        if (err) {
+          reject(err);
+        } else {
+          resolve(null);
+        }
+      };
+      stream.on('drain', done);
+      stream.on('error', done);
+      stream.on('close', onClose);
+    });
+  };
+
+  // Completes a response. When 'aborted' is set, Go failed part way through
+  // and the request may already have been completed.
+  ion.commands['protocol.end'] = (args) => {
+    const response = args.aborted ? responses.get(args.id) : lookup(args.id);
+    if (response === undefined) {
+      return;
+    }
+    responses.delete(args.id);
+    if (response.stream === null) {
+      fail(response.callback, 502);
+    } else {
+      response.stream.end();
+    }
+  };
+};
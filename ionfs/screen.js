@@ -0,0 +1,34 @@
+const { app } = require('electron')
+
+module.exports = (ion) => {
+  // The screen module may not be used until the app is ready.
+  const screen = () => require('electron').screen; // eslint-disable-line global-require
+
+  const describe = (display, primaryID) => ({
+    id: display.id,
+    bounds: display.bounds,
+    workArea: display.workArea,
+    scaleFactor: display.scaleFactor,
+    rotation: display.rotation,
+    primary: display.id === primaryID,
+  });
+
+  app.on('ready', () => {
+    screen().on('display-added', (event, display) => {
+      ion.emit('display.added', { displayID: display.id });
+    });
+    screen().on('display-removed', (event, display) => {
+      ion.emit('display.removed', { displayID: display.id });
+    });
+    screen().on('display-metrics-changed', (event, display, changedMetrics) => {
+      ion.emit('display.metrics-changed', { displayID: display.id, changedMetrics });
+    });
+  });
+
+  ion.commands['screen.displays'] = () => {
+    const primaryID = screen().getPrimaryDisplay().id;
+    return screen().getAllDisplays().map((display) => describe(display, primaryID));
+  };
+
+  ion.commands['screen.cursor'] = () => screen().getCursorScreenPoint();
+};
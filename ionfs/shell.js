@@ -0,0 +1,42 @@
+const { shell } = require('electron')
+
+module.exports = (ion) => {
+  ion.commands['shell.openExternal'] = (args) => Promise.resolve(shell.openExternal(args.target))
+    .then((opened) => {
+      if (opened === false) {
+        throw new Error(`unable to open ${args.target}`);
+      }
+    });
+
+  ion.commands['shell.openPath'] = (args) => {
+    if (shell.openPath) {
+      return shell.openPath(args.target).then((err) => {
+        if (err) {
+          throw new Error(err);
+        }
+      });
+    }
+    if (!shell.openItem(args.target)) {
+      throw new Error(`unable to open ${args.target}`);
+    }
+    return null;
+  };
+
+  ion.commands['shell.showItemInFolder'] = (args) => {
+    shell.showItemInFolder(args.target);
+  };
+
+  ion.commands['shell.moveItemToTrash'] = (args) => {
+    if (shell.trashItem) {
+      return shell.trashItem(args.target);
+    }
+    if (!shell.moveItemToTrash(args.target)) {
+      throw new Error(`unable to move ${args.target} to the trash`);
+    }
+    return null;
+  };
+
+  ion.commands['shell.beep'] = () => {
+    shell.beep();
+  };
+};
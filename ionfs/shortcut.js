@@ -0,0 +1,83 @@
+const { app, BrowserWindow, globalShortcut } = require('electron')
+
+// Reports whether 'before-input-event' input matches a window accelerator, as
+// parsed by Go. Keys are compared by physical code where one is known, since
+// the character produced depends on the keyboard layout and the modifiers
+// held.
+const matches = (accelerator, input) => input.meta === accelerator.meta
+  && input.control === accelerator.control
+  && input.alt === accelerator.alt
+  && accelerator.keys.some((k) => input.shift === k.shift
+    && (k.code ? input.code === k.code : input.key.toLowerCase() === k.key));
+
+module.exports = (ion) => {
+  // Window shortcuts, keyed by window ID, then accelerator.
+  const windowShortcuts = new Map();
+
+  const lookup = (id) => {
+    const win = BrowserWindow.fromId(id);
+    if (win === null) {
+      throw new Error(`unknown window: ${id}`);
+    }
+    return win;
+  };
+
+  ion.commands['shortcut.registerGlobal'] = (args) => {
+    if (globalShortcut.isRegistered(args.accelerator)) {
+      throw new Error(`${args.accelerator} is already registered`);
+    }
+    const registered = globalShortcut.register(args.accelerator, () => {
+      ion.emit('shortcut.global', { accelerator: args.accelerator });
+    });
+    // Older versions of Electron return undefined rather than a success flag.
+    if (registered === false || !globalShortcut.isRegistered(args.accelerator)) {
+      throw new Error(`unable to register ${args.accelerator}; it may be in use by another application`);
+    }
+  };
+
+  ion.commands['shortcut.unregisterGlobal'] = (args) => {
+    globalShortcut.unregister(args.accelerator);
+  };
+
+  ion.commands['shortcut.registerWindow'] = (args) => {
+    const win = lookup(args.id);
+    const windowID = args.id;
+    let shortcuts = windowShortcuts.get(windowID);
+    if (shortcuts === undefined) {
+      shortcuts = new Map();
+      windowShortcuts.set(windowID, shortcuts);
+      win.webContents.on('before-input-event', (event, input) => {
+        if (input.type !== 'keyDown') {
+          return;
+        }
+        shortcuts.forEach((match, accelerator) => {
+          if (matches(match, input)) {
+            event.preventDefault();
+            ion.emit('shortcut.window', { accelerator, windowID });
+          }
+        });
+      });
+      win.on('closed', () => {
+        windowShortcuts.delete(windowID);
+        ion.send({ cmd: 'shortcut.windowClosed', args: { id: windowID } });
+      });
+    }
+    shortcuts.set(args.accelerator, args.match);
+  };
+
+  ion.commands['shortcut.unregisterWindow'] = (args) => {
+    const shortcuts = windowShortcuts.get(args.id);
+    if (shortcuts !== undefined) {
+      shortcuts.delete(args.accelerator);
+    }
+  };
+
+  ion.commands['shortcut.unregisterAll'] = () => {
+    globalShortcut.unregisterAll();
+    windowShortcuts.forEach((shortcuts) => shortcuts.clear());
+  };
+
+  app.on('will-quit', () => {
+    globalShortcut.unregisterAll();
+  });
+};
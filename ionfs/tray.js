@@ -0,0 +1,47 @@
+const { Tray, nativeImage } = require('electron')
+const menu = require('./menu')
+
+module.exports = (ion) => {
+  const trays = new Map();
+
+  const lookup = (id) => {
+    const tray = trays.get(id);
+    if (tray === undefined) {
+      throw new Error(`unknown tray: ${id}`);
+    }
+    return tray;
+  };
+
+  const image = (icon) => nativeImage.createFromBuffer(Buffer.from(icon, 'base64'));
+
+  ion.commands['tray.create'] = (args) => {
+    const tray = new Tray(image(args.icon));
+    tray.on('click', () => ion.emit('tray.clicked', { trayID: args.id }));
+    tray.on('double-click', () => ion.emit('tray.double-clicked', { trayID: args.id }));
+    tray.on('right-click', () => ion.emit('tray.right-clicked', { trayID: args.id }));
+    trays.set(args.id, tray);
+  };
+
+  ion.commands['tray.setIcon'] = (args) => {
+    lookup(args.id).setImage(image(args.icon));
+  };
+
+  ion.commands['tray.setToolTip'] = (args) => {
+    lookup(args.id).setToolTip(args.text || '');
+  };
+
+  ion.commands['tray.setTitle'] = (args) => {
+    lookup(args.id).setTitle(args.text || '');
+  };
+
+  ion.commands['tray.setMenu'] = (args) => {
+    lookup(args.id).setContextMenu(args.menu ? menu.build(args.menu, (menuItemID) => {
+      ion.emit('menu.clicked', { trayID: args.id, menuItemID });
+    }) : null);
+  };
+
+  ion.commands['tray.destroy'] = (args) => {
+    lookup(args.id).destroy();
+    trays.delete(args.id);
+  };
+};
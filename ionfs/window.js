@@ -0,0 +1,57 @@
+const { BrowserWindow } = require('electron')
+
+module.exports = (ion) => {
+  const lookup = (id) => {
+    const win = BrowserWindow.fromId(id);
+    if (win === null) {
+      throw new Error(`unknown window: ${id}`);
+    }
+    return win;
+  };
+
+  // Wraps code to be run in a renderer so that failures come back with their
+  // message and stack intact. This relies on eval, which a page's Content
+  // Security Policy may forbid; the code has not run when that is reported.
+  const wrap = (code) => `(() => {
+    try {
+      eval('0');
+    } catch (err) {
+      return { blocked: true };
+    }
+    return Promise.resolve()
+      .then(() => eval(${JSON.stringify(code)}))
+      .then((value) => ({ value }), (err) => ({
+        error: {
+          message: err instanceof Error ? err.message : String(err),
+          stack: err instanceof Error ? err.stack : '',
+        },
+      }));
+  })()`;
+
+  ion.commands['window.list'] = () => BrowserWindow.getAllWindows().map((win) => win.id);
+
+  ion.commands['window.main'] = () => {
+    const win = ion.mainWindow();
+    return win ? win.id : 0;
+  };
+
+  ion.commands['window.executeJavaScript'] = (args) => {
+    const { webContents } = lookup(args.id);
+    return webContents.executeJavaScript(wrap(args.code), true).then((outcome) => {
+      if (outcome.blocked) {
+        // executeJavaScript itself is not subject to the page's policy, but
+        // failures are then reported without their detail.
+        return webContents.executeJavaScript(args.code, true);
+      }
+      if (outcome.error) {
+        const err = new Error(outcome.error.message);
+        err.stack = outcome.error.stack;
+        throw err;
+      }
+      return outcome.value;
+    });
+  };
+
+  // eslint-disable-next-line no-eval
+  ion.commands['main.executeJavaScript'] = (args) => eval(args.code);
+};
@@ -10,76 +10,818 @@ import (
 
 // ionfs holds an embedded filesystem.
 var ionfs = embedded.NewEFS(map[string]*embedded.File{
+	"/appearance.js": embedded.NewFile("appearance.js", time.Now(), 2448, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xac, 0x56, 0x5d, 0x6b, 0xe4, 0x46,
+		0x10, 0x7c, 0xd7, 0xaf, 0xa8, 0x83, 0xe3, 0x24, 0xc1, 0xae, 0x0c, 0xc9, 0x43, 0xc0, 0x62, 0x63,
+		0x1c, 0xdf, 0x41, 0x02, 0xf9, 0x02, 0x5f, 0x9e, 0x8e, 0x83, 0x1b, 0x8f, 0x7a, 0x57, 0xc3, 0x8d,
+		0x66, 0x94, 0xe9, 0xd1, 0x6e, 0x8c, 0x4f, 0xff, 0x3d, 0x8c, 0x3e, 0x76, 0xc7, 0xd6, 0xda, 0xb9,
+		0x84, 0xbc, 0x18, 0xaf, 0xa6, 0xbb, 0xba, 0xba, 0xba, 0x5a, 0x23, 0x69, 0x0d, 0x7b, 0x3c, 0x40,
+		0xb4, 0x2d, 0x7a, 0x6c, 0xe0, 0xe8, 0xcf, 0x4e, 0x39, 0xca, 0x52, 0xd2, 0x24, 0xbd, 0xb3, 0x26,
+		0xcd, 0x93, 0xa4, 0xb1, 0x55, 0xa7, 0xa9, 0xa0, 0xbf, 0x5a, 0xeb, 0x3c, 0x63, 0x83, 0x4c, 0x59,
+		0x93, 0x63, 0xf3, 0x3d, 0x1e, 0x12, 0xe0, 0xe2, 0x02, 0xef, 0x6b, 0x62, 0xc2, 0x18, 0xc6, 0x68,
+		0xc4, 0x3d, 0x8c, 0xf5, 0xb8, 0x23, 0x74, 0x4c, 0x15, 0x3a, 0xe3, 0x95, 0x86, 0xaf, 0x69, 0xa8,
+		0xa2, 0x18, 0x8e, 0x44, 0x75, 0x5f, 0xc0, 0x08, 0xaf, 0xf6, 0xf4, 0xbe, 0xa6, 0x86, 0xa0, 0x38,
+		0xa4, 0x8c, 0x68, 0xad, 0x23, 0x26, 0xe3, 0xa1, 0x0c, 0xac, 0xae, 0xc8, 0x61, 0x4f, 0x8e, 0x95,
+		0x35, 0x0c, 0xbb, 0xc5, 0xbb, 0x89, 0xd7, 0x2a, 0x1c, 0x1f, 0x6a, 0x25, 0x6b, 0x48, 0xc1, 0x04,
+		0xbe, 0x67, 0x4f, 0xcd, 0xef, 0x8e, 0xb6, 0xe4, 0xc8, 0x48, 0xe2, 0x19, 0xcb, 0xee, 0x55, 0x45,
+		0x8c, 0x43, 0x2d, 0x3c, 0x94, 0x87, 0x14, 0xa6, 0x48, 0x80, 0xb1, 0xef, 0x98, 0xc1, 0x06, 0xd9,
+		0xd0, 0xd2, 0x19, 0x05, 0x8a, 0x28, 0xae, 0x0c, 0xa8, 0xc4, 0x5a, 0x19, 0xbf, 0xae, 0x14, 0x8b,
+		0x3b, 0x4d, 0x6b, 0xad, 0x0c, 0x61, 0xa7, 0xed, 0x9d, 0xd0, 0xeb, 0x29, 0xfd, 0x58, 0x62, 0xc1,
+		0xeb, 0xc5, 0x42, 0x8b, 0xe8, 0xaf, 0x2d, 0x77, 0xac, 0x27, 0x3b, 0xe7, 0x82, 0x78, 0x73, 0x95,
+		0x30, 0x21, 0x40, 0x6d, 0x91, 0x45, 0x4d, 0x64, 0x79, 0x3e, 0x1d, 0x00, 0x8e, 0x7c, 0xe7, 0xcc,
+		0xf1, 0x27, 0x50, 0x09, 0xf7, 0xf9, 0x17, 0x5b, 0xd1, 0x65, 0x2c, 0x4f, 0x96, 0x17, 0x5c, 0xdb,
+		0x4e, 0x57, 0x7f, 0x30, 0xbd, 0x15, 0xee, 0xf3, 0x8d, 0xd5, 0xd6, 0xf1, 0xea, 0x98, 0x54, 0xab,
+		0x5d, 0x7d, 0x63, 0x8d, 0x77, 0x82, 0xfd, 0xb3, 0x89, 0x3f, 0x46, 0x41, 0x8f, 0x01, 0xfa, 0x72,
+		0x40, 0xea, 0x87, 0xbf, 0xe3, 0x6c, 0x5a, 0x47, 0xdb, 0xe0, 0xb5, 0x85, 0x24, 0x59, 0x5e, 0x26,
+		0x67, 0x88, 0x9f, 0x68, 0x0f, 0x99, 0x85, 0xe2, 0xb7, 0xd3, 0x13, 0x5c, 0x2d, 0x1e, 0x65, 0x39,
+		0x2e, 0xb1, 0x15, 0x9a, 0x69, 0x95, 0x9c, 0x6b, 0x60, 0x8e, 0x5f, 0x30, 0xbe, 0x95, 0x41, 0x0d,
+		0x5c, 0xfd, 0x53, 0xc4, 0xd3, 0x02, 0x43, 0x83, 0x7d, 0x99, 0x24, 0x80, 0x26, 0x0f, 0x2d, 0x38,
+		0x8c, 0xc8, 0x74, 0x5a, 0x97, 0xa7, 0xd1, 0xd5, 0xc2, 0xec, 0xa8, 0x7a, 0x32, 0xba, 0xf1, 0x4c,
+		0xb4, 0x2d, 0x09, 0x27, 0x8c, 0x0c, 0x46, 0x9d, 0x66, 0x3c, 0x0b, 0x11, 0x86, 0x3b, 0x02, 0x6e,
+		0x46, 0x48, 0x7c, 0xf9, 0x32, 0x54, 0x28, 0x66, 0x4d, 0xf0, 0x6a, 0xb3, 0x89, 0x20, 0x8e, 0xcf,
+		0xa7, 0xde, 0xe7, 0xf0, 0x58, 0x83, 0xa7, 0x29, 0xf1, 0xd9, 0xc9, 0x3c, 0x53, 0x1f, 0xa7, 0xb8,
+		0x72, 0x3a, 0x50, 0xd6, 0x14, 0xd4, 0x28, 0x9f, 0xa5, 0x11, 0xc6, 0xd4, 0x60, 0xba, 0x8a, 0x80,
+		0xa7, 0x1e, 0xfa, 0xa3, 0x3c, 0xa2, 0x6d, 0x0b, 0x6b, 0xb2, 0x74, 0x78, 0x49, 0xa4, 0xab, 0x47,
+		0x5a, 0x4c, 0xe5, 0xce, 0xf4, 0xff, 0x8c, 0xb9, 0x1f, 0x1b, 0x31, 0xc0, 0x76, 0x6d, 0x25, 0xfc,
+		0xc0, 0x61, 0x62, 0x93, 0x97, 0x8f, 0x16, 0xe1, 0x3f, 0x18, 0x31, 0xe8, 0xdf, 0x3a, 0x2b, 0x89,
+		0xb9, 0x68, 0xb5, 0xf0, 0x5b, 0xeb, 0x9a, 0x61, 0x16, 0x69, 0x25, 0xdc, 0x41, 0x99, 0xf4, 0xc4,
+		0x67, 0xc0, 0x2a, 0xb8, 0xbb, 0x63, 0xe9, 0xd4, 0x1d, 0xfd, 0x6a, 0xbd, 0xda, 0x2a, 0x29, 0xbc,
+		0x0a, 0xd4, 0xae, 0xdb, 0x56, 0xd3, 0x4f, 0xc6, 0x93, 0xdb, 0x0a, 0x39, 0xae, 0xdd, 0xcd, 0xc8,
+		0x31, 0x8e, 0x5b, 0x30, 0xef, 0x41, 0x9a, 0xe9, 0x05, 0x16, 0x07, 0x65, 0xbe, 0xfd, 0x66, 0x41,
+		0x22, 0x54, 0x54, 0x66, 0x4f, 0xce, 0x53, 0xb5, 0x96, 0xc1, 0xb9, 0x6b, 0x1e, 0xcc, 0xbd, 0x9e,
+		0xe0, 0xcf, 0x48, 0x74, 0xca, 0x0c, 0x76, 0x58, 0xcb, 0xc9, 0x0f, 0x5f, 0x97, 0x1e, 0x34, 0xed,
+		0xf3, 0x61, 0x05, 0x82, 0x3b, 0xa4, 0x6d, 0x1a, 0x61, 0x2a, 0xfe, 0x10, 0x3b, 0x64, 0x47, 0x3e,
+		0xfd, 0x78, 0x5c, 0x80, 0x68, 0xce, 0x2f, 0x25, 0x31, 0xf9, 0x41, 0xac, 0x5b, 0xdb, 0x39, 0x49,
+		0x63, 0xbe, 0x70, 0x3b, 0x8e, 0x8c, 0x13, 0xc4, 0x79, 0xf5, 0x21, 0x1d, 0x47, 0x98, 0xae, 0x90,
+		0x6a, 0xb5, 0xab, 0x7d, 0xf8, 0x27, 0xac, 0x42, 0xfa, 0xb1, 0x50, 0x46, 0xea, 0xae, 0x22, 0x1e,
+		0x12, 0x0b, 0x1e, 0x90, 0x22, 0x1f, 0xf9, 0xda, 0xd9, 0x03, 0x0c, 0x1d, 0xf0, 0xce, 0x39, 0xeb,
+		0xb2, 0x4f, 0xca, 0xec, 0x85, 0x56, 0x55, 0xb8, 0xd5, 0x1a, 0xc2, 0x18, 0x7f, 0x89, 0xd7, 0x0f,
+		0x51, 0x7a, 0xff, 0x29, 0x6a, 0xfc, 0x5f, 0xb8, 0xd4, 0x9f, 0x7a, 0x09, 0xab, 0x75, 0x02, 0x7c,
+		0xde, 0xa8, 0x17, 0x17, 0xf8, 0x81, 0xb6, 0xd6, 0x51, 0x0c, 0xb5, 0x42, 0x23, 0xe4, 0x6f, 0xb7,
+		0x90, 0xe1, 0xed, 0x0b, 0xf6, 0x4a, 0x6b, 0xd8, 0x3d, 0x39, 0xa7, 0x2a, 0x9a, 0x6f, 0xe3, 0x49,
+		0xc2, 0x70, 0xb1, 0xfa, 0x9a, 0x66, 0xac, 0x70, 0x4d, 0x0b, 0x86, 0xc0, 0xa1, 0xb6, 0x9a, 0x8a,
+		0xff, 0x75, 0x17, 0xf0, 0xe6, 0xcd, 0xbc, 0x05, 0xe4, 0xaf, 0xdb, 0xf6, 0x67, 0xda, 0x93, 0xbe,
+		0x3e, 0x32, 0x59, 0xac, 0xca, 0xb9, 0xa0, 0x78, 0x48, 0xe3, 0xa2, 0x4d, 0x83, 0xc5, 0xd5, 0xf8,
+		0xfe, 0xbb, 0x8c, 0x65, 0x9b, 0x98, 0x61, 0xb6, 0x63, 0x96, 0x3f, 0x2f, 0xe4, 0x62, 0xce, 0x4c,
+		0xde, 0x2b, 0xb3, 0x1b, 0xf4, 0x8a, 0x67, 0x3d, 0xdf, 0xde, 0x7c, 0xfc, 0x20, 0xc1, 0x77, 0xb0,
+		0x0e, 0x5a, 0x78, 0x72, 0x25, 0x7c, 0xad, 0x18, 0x2a, 0x3a, 0x7c, 0xfd, 0x30, 0xab, 0x32, 0x7f,
+		0xcc, 0x14, 0xf3, 0xb5, 0x3f, 0xf9, 0xa4, 0x2f, 0x93, 0xbe, 0x4c, 0xfe, 0x1e, 0x00, 0xdd, 0x95,
+		0x9f, 0x26, 0x90, 0x09, 0x00, 0x00,
+	}),
+	"/clipboard.js": embedded.NewFile("clipboard.js", time.Now(), 1731, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8c, 0x54, 0x4d, 0x6b, 0xdb, 0x40,
+		0x10, 0xbd, 0xfb, 0x57, 0x4c, 0xa0, 0x20, 0x09, 0x8c, 0x92, 0x42, 0xe9, 0x21, 0x41, 0x29, 0x14,
+		0x92, 0xb6, 0xd0, 0x2f, 0xda, 0xdc, 0x42, 0x20, 0x6b, 0x69, 0x64, 0x6d, 0xb3, 0x1f, 0xee, 0xee,
+		0x28, 0x4a, 0xb0, 0xf5, 0xdf, 0xcb, 0x6a, 0xd7, 0xb2, 0x2c, 0x0b, 0xbb, 0x27, 0x1b, 0xcd, 0xbc,
+		0x37, 0x33, 0xef, 0xcd, 0x6c, 0xae, 0x95, 0x25, 0x58, 0x43, 0x2e, 0xf8, 0x6a, 0xa1, 0x99, 0x29,
+		0xe6, 0xa0, 0x18, 0xf1, 0x67, 0xfc, 0x22, 0xd9, 0x12, 0xa1, 0x85, 0x0c, 0x0c, 0xfe, 0xad, 0xb9,
+		0xc1, 0x38, 0x42, 0x81, 0x39, 0x19, 0xad, 0xa2, 0x64, 0x36, 0x93, 0xba, 0xa8, 0x05, 0xa6, 0xf8,
+		0xb2, 0xd2, 0x86, 0x2c, 0x64, 0x10, 0x73, 0xad, 0x12, 0xc8, 0xae, 0x61, 0x3d, 0x03, 0xe0, 0x5a,
+		0xa5, 0xb9, 0x96, 0x92, 0xa9, 0xc2, 0xde, 0x47, 0x3d, 0x77, 0xda, 0x18, 0x4e, 0x18, 0x3d, 0xb8,
+		0x74, 0x66, 0x96, 0xb6, 0xcf, 0x07, 0xf0, 0x7d, 0x14, 0x8c, 0x18, 0x64, 0xb0, 0x6e, 0xaf, 0xba,
+		0x8f, 0xbc, 0xf4, 0x79, 0x29, 0xe1, 0x0b, 0xc1, 0x59, 0x96, 0x41, 0xad, 0x0a, 0x2c, 0xb9, 0xc2,
+		0x22, 0x09, 0x38, 0xe8, 0x30, 0x3e, 0x21, 0x83, 0x3e, 0xd9, 0xe3, 0xdb, 0x7d, 0x96, 0x8a, 0xa4,
+		0x38, 0xca, 0xd2, 0x25, 0x04, 0x16, 0xf7, 0x7f, 0x92, 0xc5, 0x50, 0x79, 0x94, 0xc4, 0xc5, 0x03,
+		0x87, 0xa1, 0x72, 0x92, 0x62, 0xa5, 0x96, 0x47, 0x29, 0x78, 0xa7, 0x7d, 0x36, 0x74, 0x22, 0xcd,
+		0x0d, 0x32, 0xc2, 0x5b, 0xa3, 0xe5, 0xc7, 0xba, 0x2c, 0xd1, 0xc4, 0xfe, 0x27, 0x2d, 0x8d, 0x96,
+		0x3d, 0xeb, 0x1c, 0xa2, 0x05, 0xb3, 0xf8, 0xfe, 0x5d, 0x94, 0x24, 0xc3, 0xca, 0xe7, 0xe7, 0xd0,
+		0x49, 0xef, 0x31, 0x60, 0x70, 0x25, 0x58, 0x8e, 0x16, 0xa8, 0xc2, 0x9d, 0xf3, 0xce, 0x03, 0x42,
+		0x45, 0x16, 0xb4, 0x02, 0xab, 0x25, 0xc2, 0x4a, 0x30, 0x2a, 0xb5, 0x91, 0x76, 0x0e, 0x56, 0x03,
+		0xa7, 0x2d, 0x57, 0xce, 0x94, 0xd2, 0x04, 0x0b, 0x84, 0x5c, 0xcb, 0x85, 0x1b, 0x00, 0x1a, 0x4e,
+		0x15, 0x30, 0xf5, 0x4a, 0x15, 0x57, 0x4b, 0x40, 0x61, 0x31, 0x1d, 0xf8, 0x9a, 0xd7, 0x96, 0xb4,
+		0x84, 0x0c, 0x7e, 0x2c, 0xfe, 0x60, 0x4e, 0xe9, 0x13, 0xbe, 0x5a, 0xdf, 0x74, 0x88, 0x6c, 0x36,
+		0xb0, 0x6e, 0x43, 0xcb, 0x4e, 0x26, 0xff, 0x39, 0x15, 0xa8, 0x96, 0x54, 0x41, 0x96, 0x65, 0x70,
+		0xb1, 0xd3, 0x68, 0xb4, 0x4f, 0xb1, 0xd3, 0x2c, 0x60, 0x01, 0x0c, 0x52, 0x6d, 0xd4, 0x58, 0xf6,
+		0x7d, 0xbe, 0x6b, 0x78, 0x0b, 0x9b, 0xcd, 0x5e, 0x33, 0x1d, 0xc7, 0x36, 0x7e, 0xb6, 0x5f, 0x8f,
+		0x2a, 0xa3, 0x1b, 0x50, 0xd8, 0xc0, 0x8d, 0x31, 0xda, 0xc4, 0x11, 0x83, 0xd0, 0xf6, 0x4e, 0x3b,
+		0x27, 0x13, 0x23, 0x90, 0xb5, 0xed, 0x74, 0x71, 0x8d, 0x11, 0x2a, 0xa7, 0x24, 0x77, 0x82, 0x36,
+		0x2a, 0x0a, 0x1d, 0xb6, 0xb3, 0x89, 0x11, 0x82, 0xa5, 0x9e, 0xf5, 0xfe, 0xe2, 0x61, 0x0e, 0x07,
+		0xee, 0x86, 0x58, 0x9f, 0xf2, 0x30, 0xf6, 0xba, 0xbd, 0x9a, 0x1d, 0xb9, 0xbc, 0x5c, 0x20, 0x33,
+		0xfe, 0xf2, 0x86, 0x57, 0xb7, 0x1f, 0x8f, 0xff, 0x83, 0xc8, 0x0f, 0x6a, 0x07, 0x54, 0xbb, 0x18,
+		0x7b, 0x66, 0x5c, 0xb0, 0x85, 0xc0, 0x5b, 0x9f, 0x14, 0x27, 0x47, 0xa9, 0x0c, 0xb2, 0x62, 0xf2,
+		0x31, 0xb0, 0x0d, 0xa7, 0xbc, 0x0a, 0xc7, 0xe2, 0x0b, 0x0e, 0xdc, 0x67, 0x16, 0x21, 0x72, 0x07,
+		0x1f, 0x5d, 0x86, 0x4f, 0x5b, 0xd7, 0x07, 0xf3, 0x38, 0xee, 0x3b, 0x7c, 0xa1, 0xb8, 0x5f, 0x0c,
+		0x8f, 0x73, 0x67, 0x7d, 0x0a, 0xf7, 0xf9, 0xee, 0xdb, 0xd7, 0x31, 0xce, 0x50, 0x79, 0x0a, 0xf6,
+		0xeb, 0xee, 0x76, 0x87, 0x2a, 0xb0, 0x64, 0xb5, 0xa0, 0x1d, 0x64, 0xbc, 0x44, 0x8f, 0xb5, 0x7a,
+		0x52, 0xba, 0x51, 0x07, 0x3b, 0x74, 0x09, 0x6f, 0xd6, 0x83, 0xc1, 0xdb, 0xc7, 0xc0, 0xd9, 0x9e,
+		0xb4, 0xc6, 0x35, 0xd1, 0x3d, 0xdb, 0x87, 0x3e, 0x77, 0x57, 0xb8, 0x7d, 0x56, 0x26, 0x10, 0xdb,
+		0xc6, 0xc3, 0x60, 0x5d, 0x66, 0xca, 0xed, 0x8d, 0x5c, 0xd1, 0x6b, 0x9c, 0xc0, 0x07, 0x50, 0xb5,
+		0x10, 0x70, 0x19, 0x02, 0xa4, 0x7f, 0x7e, 0xff, 0x14, 0x27, 0x29, 0xe9, 0xdf, 0x64, 0xb8, 0x5a,
+		0xc6, 0xfd, 0x26, 0x9e, 0xde, 0x1f, 0x57, 0xd2, 0x2f, 0xf7, 0xc8, 0xfa, 0xa9, 0x94, 0xbd, 0x0d,
+		0x98, 0x2c, 0xd7, 0x5e, 0xcd, 0xfe, 0x0d, 0x00, 0x80, 0xf2, 0x93, 0x97, 0xc3, 0x06, 0x00, 0x00,
+	}),
+	"/drop.js": embedded.NewFile("drop.js", time.Now(), 769, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0x9c, 0x40,
+		0x10, 0x85, 0x7b, 0x7e, 0xc5, 0x44, 0x8a, 0xc4, 0x22, 0x91, 0x55, 0x8a, 0x28, 0x05, 0x27, 0x52,
+		0x38, 0x4e, 0xa4, 0x2b, 0x5c, 0xbb, 0xb0, 0x2c, 0x79, 0xcd, 0x0e, 0x77, 0xab, 0xc0, 0x0c, 0x99,
+		0x5d, 0xcc, 0x59, 0x27, 0xfe, 0x7b, 0xc4, 0x02, 0xc7, 0xc5, 0x6e, 0x5c, 0x31, 0xd2, 0x9b, 0xf7,
+		0xf4, 0xf6, 0x63, 0x2a, 0x26, 0x1f, 0xe0, 0x0c, 0x37, 0xc2, 0x83, 0x47, 0xb9, 0x77, 0x64, 0x79,
+		0xc8, 0xc1, 0x75, 0xd5, 0x9d, 0x71, 0x94, 0x03, 0x99, 0xe0, 0x5e, 0x70, 0xdf, 0x9a, 0x03, 0xc2,
+		0x08, 0x25, 0x08, 0xfe, 0xed, 0x9d, 0xa0, 0x4a, 0xb1, 0xc1, 0x2a, 0x08, 0x53, 0x9a, 0x25, 0x49,
+		0xcb, 0xb6, 0x6f, 0x50, 0xe3, 0xa9, 0x63, 0x09, 0x1e, 0x4a, 0x50, 0x8e, 0x29, 0x83, 0xf2, 0x07,
+		0x9c, 0x13, 0x58, 0xb3, 0x34, 0x93, 0x4a, 0x1d, 0x93, 0xae, 0x5d, 0x83, 0xfe, 0x8b, 0x15, 0xee,
+		0x3a, 0xb4, 0x69, 0x0e, 0x0a, 0x5f, 0x90, 0x42, 0x0e, 0xd6, 0x04, 0x73, 0x31, 0x01, 0xcc, 0xcd,
+		0x06, 0x47, 0x50, 0xfe, 0xdf, 0x4e, 0xd7, 0xc2, 0xed, 0x3d, 0x3e, 0xff, 0x64, 0x0a, 0x48, 0xc1,
+		0xcf, 0x7e, 0xed, 0x91, 0x2c, 0x4a, 0xb6, 0x8b, 0x66, 0x57, 0x83, 0x9a, 0xac, 0x9f, 0xca, 0x12,
+		0xa8, 0x6f, 0x9a, 0x6c, 0x09, 0x05, 0x98, 0x1a, 0x60, 0xeb, 0x82, 0x4a, 0x87, 0x25, 0xed, 0x4d,
+		0x9d, 0x75, 0x11, 0x60, 0x5e, 0xd8, 0xdf, 0x16, 0xd3, 0xa4, 0x9d, 0xcd, 0x2f, 0x4a, 0x67, 0xc2,
+		0xd1, 0x17, 0xb1, 0xb1, 0x8e, 0xf3, 0x26, 0x9d, 0x0a, 0xb8, 0x33, 0xe1, 0xa8, 0x85, 0x7b, 0xb2,
+		0x2a, 0x6e, 0x9c, 0xb2, 0x4d, 0x7e, 0x7d, 0x2f, 0xbf, 0x5e, 0xe4, 0x71, 0x69, 0x3f, 0x26, 0xf3,
+		0x3c, 0xc1, 0x63, 0xd2, 0x15, 0xb7, 0xad, 0x21, 0xeb, 0x1f, 0xd6, 0xca, 0x3e, 0x18, 0x09, 0xb7,
+		0x62, 0x0e, 0xe9, 0xe3, 0x04, 0xdb, 0xc8, 0xc1, 0x7f, 0x18, 0xdc, 0xde, 0xc6, 0x7d, 0xed, 0xec,
+		0x1b, 0x54, 0xe5, 0x3b, 0x54, 0xe1, 0x28, 0x3c, 0x00, 0xe1, 0x00, 0xbf, 0x44, 0x58, 0xd4, 0x53,
+		0x4f, 0x7f, 0x88, 0x07, 0x5a, 0xc0, 0x14, 0xf0, 0xf9, 0xbc, 0x44, 0x8d, 0x4f, 0x57, 0xcd, 0x23,
+		0x38, 0x3d, 0x6c, 0x3f, 0x68, 0xeb, 0xab, 0xd6, 0xe8, 0xe9, 0x06, 0x0a, 0x88, 0xee, 0xc8, 0xef,
+		0xe1, 0xeb, 0xe3, 0x0a, 0x61, 0x92, 0xfc, 0xb5, 0xb6, 0x0a, 0xae, 0x62, 0x2a, 0xae, 0x0f, 0x52,
+		0x57, 0x82, 0x26, 0xe0, 0x6f, 0xe1, 0xf6, 0xa6, 0xaf, 0x6b, 0x14, 0x35, 0x7f, 0xe2, 0x3b, 0x97,
+		0x57, 0x56, 0x4c, 0x39, 0xa4, 0xcf, 0xc6, 0xe3, 0xf7, 0x6f, 0x69, 0xb6, 0x90, 0x9e, 0xd8, 0x02,
+		0x8c, 0xbb, 0x64, 0xdc, 0x25, 0xff, 0x06, 0x00, 0x05, 0x0f, 0xcc, 0xed, 0x01, 0x03, 0x00, 0x00,
+	}),
 	"/index.html": embedded.NewFile("index.html", time.Now(), 207, true, []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x3c, 0x8e, 0x3d, 0x0b, 0xc2, 0x30,
-		0x10, 0x86, 0xf7, 0xfe, 0x8a, 0x6b, 0x66, 0x4b, 0x90, 0x2e, 0x0e, 0x97, 0x2c, 0xea, 0xac, 0x43,
-		0x41, 0x1c, 0x63, 0x72, 0x92, 0x40, 0x3e, 0xa4, 0x3d, 0x5b, 0xfc, 0xf7, 0xd2, 0x06, 0x9d, 0xee,
-		0x8e, 0x7b, 0x78, 0x9f, 0x17, 0xdb, 0xd3, 0xe5, 0x38, 0xdc, 0xaf, 0x67, 0xf0, 0x9c, 0xa2, 0x6e,
-		0xf0, 0x37, 0xc8, 0x38, 0xdd, 0x00, 0x60, 0x22, 0x36, 0x60, 0xbd, 0x19, 0x27, 0x62, 0x25, 0xde,
-		0xfc, 0xec, 0x0e, 0x62, 0x7b, 0x70, 0xe0, 0x48, 0x7a, 0xa0, 0x89, 0x51, 0xd6, 0xfd, 0x8f, 0x67,
-		0x93, 0x48, 0x89, 0x39, 0xd0, 0xf2, 0x2a, 0x23, 0x0b, 0xb0, 0x25, 0x33, 0x65, 0x56, 0x62, 0x09,
-		0x8e, 0xbd, 0x72, 0x34, 0x07, 0x4b, 0xdd, 0x76, 0xec, 0x20, 0xe4, 0xc0, 0xc1, 0xc4, 0x6e, 0xb2,
-		0x26, 0x92, 0xda, 0x0b, 0xdd, 0xa0, 0xac, 0x76, 0x7c, 0x14, 0xf7, 0xd9, 0x52, 0x7d, 0xaf, 0x6f,
-		0x14, 0x6d, 0x49, 0x04, 0x5c, 0x60, 0x75, 0xb6, 0x28, 0x7d, 0xbf, 0xa2, 0x95, 0x41, 0x59, 0x7b,
-		0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x0f, 0x33, 0x8e, 0xcf, 0x00, 0x00, 0x00,
-	}),
-	"/ion.js": embedded.NewFile("ion.js", time.Now(), 1849, true, []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x74, 0x54, 0x4d, 0x8f, 0xdb, 0x36,
-		0x13, 0xbe, 0xeb, 0x57, 0xcc, 0x0b, 0xbc, 0x80, 0x6c, 0xd4, 0x96, 0x73, 0x2a, 0x8a, 0x2c, 0x5c,
-		0xa0, 0xdd, 0x04, 0x68, 0xda, 0x02, 0x69, 0xba, 0x29, 0xda, 0x9e, 0x12, 0x9a, 0x1c, 0x5b, 0xd3,
-		0x50, 0x1c, 0x85, 0x1c, 0xae, 0xe3, 0x2e, 0xfc, 0xdf, 0x8b, 0x21, 0x25, 0xaf, 0x37, 0x4d, 0x8f,
-		0x92, 0x46, 0xcf, 0xf0, 0xf9, 0xa2, 0xe5, 0x90, 0x04, 0x1e, 0xc0, 0x8c, 0xe3, 0x0a, 0xbe, 0x8f,
-		0x7c, 0x4c, 0x18, 0x7f, 0xa7, 0xe0, 0xf8, 0x08, 0x67, 0xd8, 0x42, 0xc4, 0x8f, 0x99, 0x22, 0x2e,
-		0x5a, 0xf4, 0x68, 0x25, 0x72, 0x68, 0x97, 0x4d, 0xb3, 0xd9, 0xc0, 0x0f, 0x26, 0x38, 0x8f, 0x60,
-		0x23, 0x1a, 0xa1, 0x70, 0xd8, 0x44, 0x1c, 0xf8, 0x9e, 0xc2, 0x01, 0x52, 0xcf, 0x51, 0x6c, 0x96,
-		0x04, 0x1c, 0xa0, 0x02, 0x25, 0x38, 0xf6, 0x18, 0x80, 0x42, 0x12, 0xe3, 0xbd, 0x4e, 0xe7, 0xf0,
-		0xf8, 0xd0, 0x29, 0x1c, 0xed, 0x61, 0xf1, 0xaf, 0x55, 0xeb, 0xa4, 0x2f, 0x22, 0xfa, 0x75, 0x12,
-		0x13, 0x25, 0x8f, 0xed, 0x72, 0x09, 0x0f, 0xb0, 0xd9, 0x00, 0x26, 0x4f, 0x41, 0xd6, 0x8e, 0x92,
-		0xd9, 0x79, 0x5c, 0x7b, 0x0a, 0x08, 0x07, 0xcf, 0x3b, 0xe3, 0xd7, 0x13, 0x8a, 0x82, 0x82, 0x92,
-		0xea, 0x3e, 0x66, 0x92, 0xc5, 0xf2, 0x46, 0x5f, 0x9c, 0xcb, 0xd1, 0x7f, 0x42, 0x1c, 0xc1, 0x4c,
-		0xf3, 0x10, 0x71, 0x8f, 0x11, 0x83, 0x45, 0xe0, 0x3d, 0x48, 0x8f, 0x70, 0xac, 0xe4, 0x79, 0xf7,
-		0x17, 0x5a, 0x59, 0xe9, 0xc9, 0x4e, 0x9c, 0xc1, 0x71, 0x68, 0x65, 0x75, 0x3d, 0x70, 0x24, 0xef,
-		0x15, 0x6e, 0x87, 0x60, 0x3d, 0x27, 0x74, 0x60, 0xb2, 0xf0, 0x60, 0x84, 0xac, 0xf1, 0xfe, 0x54,
-		0x39, 0xeb, 0xfc, 0x8f, 0xe6, 0xde, 0xdc, 0xd9, 0x48, 0xa3, 0x4c, 0xa0, 0x40, 0x09, 0x0e, 0x26,
-		0xee, 0xcc, 0x01, 0xc1, 0xb2, 0x57, 0xb6, 0xe8, 0xba, 0xc6, 0xa3, 0xc0, 0x60, 0x28, 0x54, 0xd1,
-		0x6e, 0x9a, 0xa6, 0x5a, 0x53, 0x24, 0xc6, 0xc9, 0x92, 0x2d, 0x2c, 0x96, 0xb0, 0xfd, 0x16, 0x1e,
-		0x1a, 0x50, 0x1d, 0x6e, 0xcb, 0xb7, 0xb2, 0x65, 0x57, 0xad, 0x9b, 0x4e, 0xd7, 0x35, 0x70, 0x85,
-		0x05, 0x5b, 0x08, 0x78, 0x7c, 0xea, 0xee, 0x42, 0x21, 0x00, 0x8e, 0xe4, 0xa4, 0x7f, 0x0e, 0xdf,
-		0x3c, 0x7b, 0xb6, 0x2a, 0xcf, 0x3d, 0xd2, 0xa1, 0x97, 0xe7, 0xf0, 0x75, 0x7d, 0x71, 0x5e, 0xde,
-		0x34, 0x75, 0x95, 0x09, 0x0e, 0x3c, 0x1b, 0x57, 0x96, 0x51, 0x70, 0xf8, 0xa9, 0xeb, 0x65, 0xf0,
-		0xb3, 0x6a, 0xaa, 0xf4, 0x93, 0x9d, 0x9d, 0x0e, 0xff, 0xf6, 0xeb, 0xcf, 0x8b, 0xf7, 0x7b, 0xf2,
-		0xf8, 0x7c, 0xb3, 0xf9, 0xff, 0xc3, 0xbb, 0x77, 0x8e, 0x62, 0x30, 0x03, 0x9e, 0x37, 0x8f, 0x00,
-		0xef, 0x2f, 0x1b, 0x5e, 0x8f, 0x93, 0x60, 0x2f, 0xf0, 0xfe, 0x2d, 0xb3, 0x4f, 0x5d, 0x35, 0xf1,
-		0x0a, 0xf3, 0x88, 0xbb, 0x5b, 0x0e, 0x82, 0x41, 0x52, 0xc7, 0x23, 0x86, 0x79, 0x72, 0x71, 0x01,
-		0x79, 0x39, 0x90, 0x08, 0xba, 0x47, 0xf5, 0x27, 0xb7, 0x28, 0x4d, 0x2e, 0x7d, 0x76, 0x4a, 0x0e,
-		0x8b, 0xb6, 0x7e, 0x68, 0x57, 0x57, 0xda, 0x16, 0xac, 0x17, 0xf8, 0x18, 0x8e, 0x2f, 0x24, 0x23,
-		0xa7, 0x5c, 0x8c, 0xd6, 0x78, 0x1c, 0x39, 0x7b, 0x07, 0x49, 0x38, 0xce, 0x63, 0x69, 0x46, 0xa1,
-		0x00, 0x26, 0x80, 0x89, 0xd1, 0x9c, 0xa6, 0x30, 0x45, 0x55, 0x0b, 0x52, 0x1e, 0x47, 0x8e, 0x92,
-		0x60, 0xc8, 0x5e, 0x68, 0xfe, 0x4b, 0x23, 0x46, 0x49, 0x8f, 0xab, 0x1b, 0x85, 0x06, 0x9c, 0x71,
-		0x0a, 0x23, 0xdd, 0x95, 0xfa, 0xb2, 0xcc, 0xa1, 0xc7, 0xc9, 0x7b, 0xcb, 0x31, 0x62, 0x1a, 0x39,
-		0x38, 0x6d, 0x20, 0x7a, 0x1c, 0x30, 0x48, 0x57, 0x7e, 0x7c, 0x1a, 0x82, 0xec, 0xfd, 0xcd, 0xe4,
-		0xea, 0xf9, 0xa6, 0x94, 0xe1, 0xad, 0x6e, 0x1b, 0x50, 0x7a, 0x76, 0x25, 0xd1, 0x25, 0xce, 0xc6,
-		0xfb, 0x59, 0xc2, 0x97, 0x53, 0x17, 0xa1, 0x37, 0x09, 0xf6, 0x14, 0x28, 0xf5, 0xe8, 0x4a, 0x63,
-		0x03, 0x09, 0x19, 0x4f, 0x7f, 0x1b, 0x21, 0x0e, 0x25, 0x1e, 0x94, 0x20, 0xa2, 0x71, 0x27, 0x10,
-		0x9e, 0x52, 0xfb, 0x59, 0x2a, 0xab, 0xa3, 0x77, 0x3c, 0x20, 0x7c, 0xf7, 0xcb, 0xab, 0x04, 0xd6,
-		0x04, 0xe0, 0xe0, 0x4f, 0xba, 0x34, 0x97, 0x06, 0xed, 0x05, 0x63, 0x55, 0x00, 0xef, 0x31, 0x08,
-		0xb0, 0xb5, 0x39, 0xa6, 0xae, 0xd1, 0x78, 0xa9, 0x55, 0x05, 0xbf, 0x5d, 0x3d, 0x29, 0xc5, 0xb2,
-		0x32, 0x79, 0x93, 0x49, 0xea, 0x99, 0x8d, 0xf7, 0xf3, 0x42, 0x30, 0x11, 0x2f, 0xc6, 0xcf, 0x20,
-		0xf5, 0xdb, 0xda, 0x78, 0xbf, 0xfe, 0x82, 0xf5, 0x9a, 0xc4, 0x00, 0xaf, 0xef, 0xe0, 0x0f, 0xa0,
-		0x52, 0x55, 0xcb, 0xc3, 0xc0, 0x01, 0xf6, 0x5c, 0x7c, 0xf3, 0x64, 0x0b, 0xe3, 0x54, 0x28, 0x4b,
-		0x8f, 0x14, 0x61, 0xc0, 0x90, 0x61, 0x67, 0x62, 0xfd, 0x5b, 0x18, 0x92, 0x98, 0x13, 0x18, 0x2b,
-		0x74, 0x8f, 0x90, 0x83, 0x90, 0x2f, 0x36, 0x65, 0x55, 0x42, 0xaf, 0xa3, 0x04, 0xf8, 0x49, 0x81,
-		0x48, 0xf4, 0x9e, 0x20, 0xe9, 0xe1, 0x76, 0x70, 0xf0, 0x15, 0xbc, 0x69, 0xa0, 0xdc, 0x84, 0x63,
-		0x64, 0x8b, 0x29, 0x75, 0xa3, 0x37, 0xb2, 0xe7, 0x38, 0xc0, 0xff, 0xb6, 0x5b, 0x68, 0x9d, 0x89,
-		0x47, 0x0a, 0xed, 0x72, 0x0a, 0xe8, 0xf5, 0xdd, 0x06, 0x70, 0x6e, 0x4a, 0x55, 0x67, 0x8a, 0x65,
-		0xb7, 0x11, 0xfc, 0x6f, 0x66, 0xed, 0x85, 0x98, 0x30, 0x44, 0x5c, 0x4f, 0x86, 0x99, 0x4b, 0x61,
-		0xc2, 0xdc, 0xeb, 0x4b, 0x97, 0x2a, 0x84, 0x63, 0xfb, 0x01, 0xc8, 0x72, 0xa8, 0x9d, 0x22, 0xfb,
-		0x41, 0x8d, 0xab, 0x5a, 0x44, 0x2c, 0x82, 0x07, 0x06, 0xd6, 0xa7, 0x8b, 0x0b, 0xda, 0xd5, 0x6e,
-		0x22, 0x77, 0x9d, 0xc8, 0x6d, 0xcd, 0xe4, 0x4c, 0xe9, 0xda, 0xd5, 0x27, 0xb4, 0x36, 0x1b, 0x78,
-		0x15, 0x6a, 0x2e, 0xf4, 0x36, 0x29, 0x35, 0xd0, 0xf0, 0x50, 0xb0, 0x3e, 0xbb, 0x5a, 0x82, 0x88,
-		0x49, 0xf4, 0x36, 0x9a, 0x0b, 0xd6, 0x26, 0x48, 0x23, 0x5a, 0xda, 0x93, 0x2d, 0x2d, 0x80, 0x49,
-		0x55, 0x05, 0xb3, 0xec, 0xb0, 0x83, 0x3f, 0x27, 0x14, 0xe3, 0x13, 0xc3, 0x98, 0x45, 0x61, 0x06,
-		0x25, 0x9e, 0x70, 0x34, 0x51, 0xd5, 0xd0, 0x65, 0xd5, 0x68, 0x1a, 0xb4, 0xac, 0x75, 0x42, 0x79,
-		0x76, 0xcd, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x32, 0x1c, 0xe8, 0x39, 0x07, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x3c, 0x8d, 0xbd, 0x0a, 0xc2, 0x30,
+		0x14, 0x85, 0xf7, 0x3c, 0xc5, 0x6d, 0x66, 0x4b, 0x90, 0x2e, 0x0e, 0x37, 0x59, 0xd4, 0x59, 0x87,
+		0x82, 0x38, 0xc6, 0xe4, 0x4a, 0x02, 0xf9, 0x91, 0xf6, 0xda, 0xe2, 0xdb, 0x4b, 0x5b, 0x74, 0x3a,
+		0xe7, 0x70, 0x3e, 0xf8, 0xb0, 0x39, 0x5d, 0x8e, 0xfd, 0xfd, 0x7a, 0x86, 0xc0, 0x39, 0x19, 0x81,
+		0xbf, 0x20, 0xeb, 0x8d, 0x00, 0xc0, 0x4c, 0x6c, 0xc1, 0x05, 0x3b, 0x8c, 0xc4, 0x5a, 0xbe, 0xf9,
+		0xd9, 0x1e, 0xe4, 0x7a, 0x70, 0xe4, 0x44, 0xa6, 0xa7, 0x91, 0x51, 0x6d, 0xfd, 0x8f, 0x17, 0x9b,
+		0x49, 0xcb, 0x29, 0xd2, 0xfc, 0xaa, 0x03, 0x4b, 0x70, 0xb5, 0x30, 0x15, 0xd6, 0x72, 0x8e, 0x9e,
+		0x83, 0xf6, 0x34, 0x45, 0x47, 0xed, 0x3a, 0x76, 0x10, 0x4b, 0xe4, 0x68, 0x53, 0x3b, 0x3a, 0x9b,
+		0x48, 0xef, 0xa5, 0x11, 0xa8, 0x36, 0x3b, 0x3e, 0xaa, 0xff, 0xac, 0xae, 0xd0, 0x99, 0x1b, 0x25,
+		0x57, 0x33, 0x01, 0x57, 0x58, 0x9c, 0x0d, 0xaa, 0xd0, 0x2d, 0xe8, 0xc6, 0xa0, 0x0a, 0x9c, 0x93,
+		0x11, 0xdf, 0x01, 0x00, 0x9c, 0x0f, 0x33, 0x8e, 0xcf, 0x00, 0x00, 0x00,
+	}),
+	"/instance.js": embedded.NewFile("instance.js", time.Now(), 762, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xdb, 0x30,
+		0x10, 0x85, 0xef, 0xfa, 0x15, 0x6f, 0x4f, 0x96, 0x69, 0xe2, 0x6c, 0xaf, 0x1b, 0xbc, 0xa5, 0xd0,
+		0x52, 0x0a, 0x85, 0x1e, 0x7a, 0x2c, 0x3d, 0x08, 0x79, 0xec, 0x0c, 0x91, 0x47, 0xae, 0x24, 0x3b,
+		0x29, 0x8b, 0xff, 0x7b, 0x51, 0xe2, 0x84, 0x6c, 0x53, 0xf6, 0x68, 0xf3, 0x79, 0xe6, 0x7b, 0x6f,
+		0x6c, 0xbd, 0xc4, 0x84, 0x17, 0x98, 0x61, 0xc0, 0x8c, 0x1a, 0x81, 0x7e, 0x8f, 0x1c, 0x48, 0x17,
+		0xe4, 0xc8, 0xa6, 0xe0, 0xa5, 0x28, 0x95, 0xda, 0x6c, 0xf0, 0x59, 0x5a, 0x1f, 0x2c, 0x45, 0x18,
+		0x44, 0x96, 0xce, 0x11, 0xc2, 0x28, 0xc2, 0xd2, 0x81, 0x25, 0x26, 0x23, 0x96, 0x56, 0x68, 0x7d,
+		0x38, 0x98, 0xd0, 0xe4, 0x97, 0x69, 0x47, 0xb0, 0xbe, 0xef, 0x8d, 0x34, 0x70, 0x2c, 0x04, 0xdf,
+		0xc2, 0xc8, 0x9f, 0x3c, 0xc9, 0x99, 0x44, 0x01, 0xce, 0x8c, 0x62, 0x77, 0x48, 0x1e, 0x69, 0xc7,
+		0x11, 0x5e, 0xa8, 0x52, 0xbd, 0x6f, 0x46, 0x47, 0x15, 0x1d, 0x07, 0x1f, 0x52, 0x44, 0x0d, 0xcd,
+		0x5e, 0x4a, 0xd4, 0xcf, 0x78, 0x51, 0x00, 0xb7, 0xd0, 0x0f, 0xec, 0xa5, 0xb2, 0x5e, 0x5a, 0xee,
+		0xaa, 0xb3, 0xc6, 0xd7, 0x65, 0x7b, 0x79, 0x62, 0x80, 0x40, 0x69, 0x0c, 0xb2, 0x55, 0xc0, 0xac,
+		0x14, 0x70, 0x8e, 0xb7, 0x88, 0xe5, 0x89, 0x26, 0x74, 0xd3, 0x0a, 0x07, 0x1f, 0xf6, 0x2c, 0xdd,
+		0x27, 0x0e, 0xd7, 0xf1, 0xc0, 0x66, 0x83, 0x2f, 0x1e, 0x83, 0x33, 0x39, 0x26, 0xa7, 0x08, 0x7f,
+		0x10, 0x98, 0xd0, 0x8d, 0x3d, 0x49, 0x8a, 0x30, 0x6d, 0xf6, 0x2e, 0xd6, 0xeb, 0xa2, 0x3a, 0xe1,
+		0xe7, 0xd1, 0x8c, 0x3a, 0x33, 0x53, 0xc5, 0xd2, 0xd0, 0xf1, 0x7b, 0xab, 0x33, 0x50, 0xe6, 0xfd,
+		0x40, 0x96, 0xa5, 0x9e, 0x93, 0x2e, 0xcc, 0x30, 0x54, 0x91, 0xac, 0x97, 0x66, 0x7d, 0xa9, 0xab,
+		0x58, 0xe5, 0xd6, 0x43, 0x37, 0x3d, 0x81, 0xf1, 0x5c, 0xe3, 0x11, 0x1f, 0x4e, 0x8f, 0x55, 0x74,
+		0x6c, 0x49, 0x33, 0xde, 0xe1, 0x7d, 0x89, 0x27, 0xfc, 0xfc, 0x75, 0xab, 0x8b, 0xf9, 0x66, 0x76,
+		0x43, 0x8e, 0x27, 0x0a, 0x1f, 0x43, 0x17, 0xef, 0x73, 0x65, 0x6c, 0xde, 0xaa, 0xa5, 0xb8, 0x2c,
+		0x90, 0x4f, 0x4b, 0x31, 0xfd, 0x78, 0xd5, 0xdb, 0x37, 0x6f, 0xf7, 0x97, 0xee, 0x32, 0xf8, 0xf0,
+		0x26, 0xa9, 0xcb, 0x0b, 0x8b, 0xfc, 0xc7, 0x54, 0x74, 0xe4, 0xa4, 0x1f, 0x17, 0xa5, 0xdb, 0xf2,
+		0x73, 0xfd, 0x17, 0xc8, 0x8b, 0x2e, 0xee, 0xc3, 0x6b, 0x9a, 0x48, 0xd2, 0x0a, 0xff, 0x3d, 0xc8,
+		0x72, 0xb0, 0xfb, 0x54, 0xa7, 0x55, 0x33, 0xc8, 0x45, 0xba, 0xe6, 0xea, 0xcd, 0x9e, 0x5e, 0xab,
+		0xea, 0xe5, 0xfb, 0xab, 0xed, 0x3f, 0xae, 0xb3, 0x9a, 0xb7, 0xea, 0xef, 0x00, 0xfa, 0x35, 0xcf,
+		0xd0, 0xfa, 0x02, 0x00, 0x00,
+	}),
+	"/ion.js": embedded.NewFile("ion.js", time.Now(), 6277, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x8d, 0x58, 0x6d, 0x73, 0x1b, 0xb7,
+		0x11, 0xfe, 0xae, 0x5f, 0x81, 0x66, 0x3c, 0xc3, 0xe3, 0x84, 0x3a, 0xb9, 0xf9, 0xd0, 0xc9, 0xd0,
+		0x23, 0x77, 0x12, 0x5b, 0x4d, 0x95, 0xa6, 0x96, 0x13, 0xb9, 0xe3, 0x76, 0x9c, 0x8c, 0x05, 0xde,
+		0x81, 0x24, 0xe2, 0x3b, 0x80, 0x01, 0x70, 0xa2, 0x59, 0x85, 0xff, 0xbd, 0xcf, 0xee, 0xe2, 0x8e,
+		0x47, 0x99, 0xce, 0x34, 0xe3, 0x48, 0x3a, 0x60, 0xb1, 0xd8, 0x97, 0x67, 0x5f, 0xb0, 0x95, 0x77,
+		0x31, 0xa9, 0x07, 0xa5, 0x37, 0x9b, 0x99, 0xfa, 0x36, 0xf8, 0x6d, 0x34, 0xe1, 0xad, 0x75, 0xb5,
+		0xdf, 0xaa, 0xbd, 0xba, 0x54, 0xc1, 0xfc, 0xd6, 0xd9, 0x60, 0x8a, 0x89, 0x69, 0x4c, 0x95, 0x82,
+		0x77, 0x93, 0xe9, 0x59, 0xc5, 0x47, 0x96, 0x71, 0xbc, 0xbd, 0x8c, 0xc3, 0x86, 0x33, 0x69, 0xbc,
+		0x83, 0xcf, 0x61, 0x6b, 0xa3, 0xd3, 0x7a, 0xbc, 0x47, 0xdf, 0xd8, 0x3c, 0xbb, 0xb8, 0x50, 0x7f,
+		0xd7, 0xae, 0x6e, 0x8c, 0xaa, 0x82, 0xd1, 0xc9, 0xba, 0xd5, 0x45, 0x30, 0xad, 0xbf, 0xc7, 0x1f,
+		0x2a, 0xae, 0x7d, 0x48, 0x55, 0x97, 0xa2, 0xf2, 0x4e, 0x89, 0x64, 0x51, 0x6d, 0xd7, 0xc6, 0x29,
+		0x0b, 0x96, 0xba, 0x69, 0x88, 0xba, 0x73, 0x87, 0x8f, 0x92, 0xd8, 0xd9, 0xa5, 0x2a, 0x3e, 0x91,
+		0xfd, 0x3c, 0xd2, 0x42, 0x30, 0xcd, 0x39, 0x68, 0x43, 0xea, 0x36, 0x93, 0xe9, 0x14, 0xaa, 0x83,
+		0xdc, 0x44, 0x9c, 0x4c, 0xe7, 0xb5, 0x8d, 0x7a, 0xd1, 0x98, 0x73, 0x7c, 0x18, 0xb5, 0x6a, 0xfc,
+		0x42, 0x37, 0xe7, 0x99, 0x0b, 0x31, 0x55, 0x64, 0xa5, 0x12, 0x9f, 0xa9, 0x98, 0x3e, 0xa3, 0x85,
+		0x3d, 0x8b, 0xfe, 0x66, 0x0d, 0xb9, 0xbd, 0x73, 0xb8, 0xc4, 0x42, 0xc6, 0x85, 0xae, 0x3e, 0xa8,
+		0xe4, 0xd5, 0x77, 0xbe, 0x54, 0xff, 0x34, 0x31, 0xea, 0x95, 0x89, 0x90, 0x55, 0x2d, 0x3c, 0x94,
+		0xaf, 0xc1, 0x8a, 0xc9, 0xa2, 0xd2, 0xc1, 0xa8, 0xef, 0x6f, 0x6f, 0x5e, 0x29, 0xbf, 0xf8, 0x15,
+		0x6b, 0x71, 0x46, 0xbc, 0x3c, 0x2e, 0xde, 0x98, 0xa0, 0x48, 0x82, 0x12, 0x2c, 0x54, 0x34, 0xae,
+		0x8e, 0x60, 0xdf, 0xb6, 0x9a, 0xfe, 0x28, 0x1e, 0x6c, 0x3d, 0x53, 0x55, 0x8b, 0x1f, 0x3a, 0xac,
+		0xe2, 0x7e, 0x3a, 0x83, 0x2d, 0x6c, 0xb5, 0x66, 0x6e, 0xda, 0xc5, 0xad, 0x09, 0xa6, 0x56, 0x5b,
+		0x9b, 0xd6, 0xc4, 0x2d, 0x98, 0x4d, 0x63, 0x0d, 0x9d, 0xa2, 0xbf, 0x76, 0x6f, 0xfc, 0x0c, 0x4b,
+		0xb1, 0x6b, 0xd2, 0x5e, 0xf9, 0xa0, 0x0e, 0x8b, 0x26, 0x04, 0x1f, 0xf6, 0x53, 0xd5, 0xb9, 0x06,
+		0x02, 0xab, 0x04, 0x85, 0x6c, 0xad, 0x2c, 0x4c, 0xde, 0xda, 0x94, 0x4c, 0xcd, 0x26, 0xbd, 0xba,
+		0x37, 0x2e, 0x11, 0x2f, 0xa7, 0x5b, 0x33, 0x53, 0x65, 0x59, 0xe2, 0x04, 0x5d, 0x0b, 0x11, 0x93,
+		0x28, 0x0c, 0x06, 0xd1, 0x37, 0xb6, 0xb2, 0x38, 0x03, 0x01, 0x45, 0xc7, 0x5e, 0x76, 0xe2, 0x51,
+		0x3c, 0x8c, 0x44, 0xc7, 0x3d, 0x3a, 0xd1, 0xa9, 0x35, 0xfb, 0x3e, 0xb2, 0xd8, 0xbe, 0x4b, 0x2c,
+		0xf6, 0x8e, 0x3d, 0xd9, 0x00, 0x4b, 0x64, 0x5a, 0x80, 0xc6, 0x75, 0x4d, 0xf3, 0x2c, 0xe3, 0x08,
+		0x44, 0x0b, 0xff, 0x11, 0x8b, 0xef, 0x7e, 0x79, 0xc6, 0x2e, 0xf8, 0x87, 0x31, 0x1b, 0xa5, 0xb3,
+		0xcb, 0x70, 0x7e, 0x09, 0x33, 0xb8, 0xca, 0x28, 0xbf, 0x64, 0x65, 0xb6, 0x02, 0x68, 0x31, 0xf4,
+		0x8c, 0xc0, 0xb1, 0xf3, 0x9d, 0xaa, 0x81, 0x66, 0x7c, 0x8d, 0x08, 0xb6, 0xb6, 0x69, 0x88, 0xdd,
+		0x02, 0x52, 0x37, 0x3e, 0xc2, 0x92, 0xba, 0x4b, 0xbe, 0x05, 0x22, 0x2b, 0x60, 0x6b, 0x27, 0xb0,
+		0x23, 0xfa, 0xef, 0xf5, 0xbd, 0xbe, 0xad, 0x82, 0xdd, 0xa4, 0xcc, 0x94, 0x8c, 0xb5, 0xd2, 0x61,
+		0x01, 0x67, 0x43, 0xe0, 0x86, 0x00, 0x97, 0xcd, 0x76, 0xcd, 0x7b, 0xb5, 0xa9, 0x1a, 0x4d, 0xae,
+		0xd1, 0x6b, 0xa3, 0x6b, 0x92, 0x6b, 0x02, 0x10, 0x4c, 0x70, 0x53, 0xa5, 0xbb, 0x68, 0x94, 0xf9,
+		0x98, 0x8c, 0x8b, 0x8c, 0x8b, 0x56, 0xef, 0x14, 0x5d, 0xa7, 0xf0, 0x55, 0xb6, 0xda, 0x3a, 0x01,
+		0x7d, 0x31, 0x25, 0x6e, 0x70, 0x36, 0xa2, 0x04, 0x22, 0xec, 0xd8, 0xb6, 0x0b, 0x43, 0x21, 0xd2,
+		0x78, 0x5d, 0xd3, 0x6d, 0x64, 0xad, 0xc3, 0x81, 0xc1, 0x66, 0xd9, 0x68, 0x84, 0xcd, 0x4b, 0xf5,
+		0x70, 0xa6, 0x08, 0xf0, 0xb7, 0x26, 0x51, 0x98, 0x45, 0x84, 0x64, 0x24, 0x3d, 0x97, 0xc1, 0xb7,
+		0xe4, 0x0a, 0x38, 0xa4, 0xd1, 0x9d, 0xab, 0xd6, 0x25, 0xe8, 0x70, 0x6e, 0x69, 0x57, 0x73, 0x46,
+		0x69, 0xb9, 0xd1, 0x21, 0x9a, 0x62, 0x13, 0x7c, 0x05, 0x88, 0x94, 0xc6, 0xdd, 0x97, 0xd7, 0x37,
+		0xaf, 0xde, 0xbf, 0xb8, 0x79, 0xf5, 0xb7, 0xeb, 0xef, 0xd4, 0xef, 0xbf, 0xab, 0xc9, 0xc3, 0x7e,
+		0x32, 0x9d, 0x9d, 0x09, 0xfb, 0x17, 0xe2, 0xf3, 0xec, 0xd9, 0x10, 0x67, 0xea, 0x83, 0xd9, 0xe1,
+		0x9a, 0xc5, 0xae, 0x87, 0x83, 0x22, 0x14, 0x95, 0xea, 0x4a, 0x03, 0xbb, 0xb0, 0x0f, 0x29, 0x9c,
+		0x81, 0x4b, 0xda, 0x09, 0x97, 0x4c, 0x3a, 0x21, 0x1c, 0xad, 0xba, 0x96, 0xe1, 0x47, 0x47, 0xc9,
+		0x42, 0xc1, 0xa4, 0x2e, 0x38, 0xf8, 0xfc, 0x5e, 0x37, 0x9d, 0x21, 0x38, 0x6b, 0x05, 0xe1, 0x5a,
+		0x1b, 0x8d, 0x88, 0x2e, 0xa0, 0x9b, 0xab, 0x87, 0x3d, 0x0b, 0x45, 0x71, 0x34, 0x57, 0x45, 0x1b,
+		0x57, 0x53, 0x75, 0xf9, 0x9c, 0xcd, 0xc0, 0x0a, 0xc2, 0x30, 0x1c, 0xed, 0x97, 0xea, 0xee, 0xc9,
+		0x03, 0x2b, 0x1a, 0x53, 0x80, 0x65, 0xec, 0x72, 0xc7, 0xc4, 0xfb, 0x9f, 0xdd, 0xdd, 0x33, 0x26,
+		0xa6, 0x94, 0x22, 0x58, 0xbc, 0x14, 0xcb, 0x4e, 0x33, 0x17, 0x95, 0xf1, 0x58, 0x6e, 0xba, 0xb8,
+		0x2e, 0x88, 0xdb, 0x54, 0x4e, 0xec, 0x95, 0x69, 0xe0, 0xdb, 0x9e, 0x8a, 0x0e, 0x97, 0xdb, 0x80,
+		0xe0, 0x38, 0x22, 0xc2, 0x4f, 0x11, 0xd1, 0x20, 0xd8, 0x20, 0xa2, 0x84, 0xd7, 0xd2, 0x9a, 0xa6,
+		0x8e, 0x23, 0x59, 0x09, 0x0d, 0xa4, 0x44, 0x71, 0xc3, 0x80, 0x2b, 0xe1, 0x37, 0xbb, 0x72, 0xc5,
+		0x03, 0x1b, 0x12, 0x1c, 0xfa, 0x13, 0xcc, 0x76, 0xdf, 0x3b, 0x82, 0x72, 0xd3, 0x06, 0x19, 0x0f,
+		0x08, 0x51, 0x51, 0x00, 0xcb, 0x51, 0x87, 0x10, 0x43, 0x5a, 0x05, 0x2e, 0x83, 0xdf, 0x6c, 0xc8,
+		0xff, 0x80, 0x55, 0x2c, 0x87, 0xfc, 0xca, 0x69, 0x98, 0xfd, 0x25, 0x6c, 0x46, 0xf0, 0x44, 0x3e,
+		0xee, 0x1a, 0x24, 0x07, 0x57, 0x35, 0x5d, 0x8d, 0x3c, 0x91, 0x28, 0xb7, 0xc1, 0x65, 0x36, 0xa8,
+		0xad, 0x59, 0xbc, 0x1e, 0x62, 0x2f, 0x52, 0x4a, 0x58, 0x99, 0xc4, 0xf1, 0x12, 0x21, 0xa3, 0x70,
+		0x5a, 0x98, 0xb5, 0xbe, 0xb7, 0x3e, 0x90, 0x93, 0xb2, 0x60, 0x73, 0xae, 0x0b, 0xe5, 0xaf, 0xde,
+		0xba, 0xe2, 0xfd, 0x7b, 0x64, 0x48, 0xb1, 0xc0, 0x24, 0x6f, 0x97, 0xbf, 0xc6, 0x03, 0xb0, 0x7e,
+		0x62, 0xb7, 0x4b, 0x8a, 0x22, 0xa8, 0xe7, 0xc8, 0x9d, 0x11, 0x00, 0xc8, 0x27, 0xe4, 0x25, 0x88,
+		0xb4, 0x46, 0xee, 0x59, 0x18, 0x04, 0xab, 0x84, 0x31, 0x5d, 0x76, 0x08, 0x0c, 0xd8, 0x98, 0xed,
+		0x7a, 0x58, 0x99, 0x9d, 0xed, 0x87, 0x30, 0xe1, 0xe4, 0xf3, 0x16, 0x38, 0xbc, 0xa2, 0xa4, 0x08,
+		0x5c, 0x10, 0x0c, 0x38, 0x45, 0x0e, 0xce, 0x10, 0x42, 0x93, 0xf7, 0xf1, 0x5b, 0x4a, 0x11, 0x94,
+		0x46, 0x5c, 0xcb, 0xb1, 0xbf, 0xf2, 0xf2, 0x1c, 0xc5, 0x70, 0x2b, 0x2b, 0xc5, 0x2d, 0xc3, 0xaa,
+		0x20, 0x3e, 0xec, 0x22, 0x82, 0x13, 0x38, 0x97, 0xb6, 0x9e, 0x3e, 0xf6, 0xf0, 0x83, 0xca, 0x99,
+		0x79, 0xae, 0x84, 0x22, 0x67, 0x68, 0x80, 0x59, 0xb5, 0x52, 0x51, 0xe6, 0xb2, 0x52, 0xe6, 0xcf,
+		0x99, 0xc2, 0xfd, 0xd5, 0x87, 0x7e, 0x95, 0x3f, 0x00, 0xbf, 0xbd, 0x80, 0x61, 0x0c, 0x43, 0x92,
+		0xdd, 0x37, 0xa6, 0x64, 0xc2, 0x82, 0x7f, 0x0a, 0xd1, 0xc8, 0x04, 0x12, 0xb5, 0x7d, 0x0c, 0x5f,
+		0x1e, 0x47, 0xcd, 0xeb, 0x1c, 0x64, 0x28, 0x24, 0xbe, 0xb9, 0x37, 0x48, 0x4b, 0xc4, 0xb6, 0x84,
+		0x43, 0x5c, 0x51, 0x8c, 0xf0, 0xda, 0x9b, 0xa9, 0x1a, 0xd8, 0x90, 0x7e, 0x7d, 0x5c, 0xbe, 0x23,
+		0xc5, 0x50, 0x0b, 0x7e, 0x79, 0x96, 0x89, 0x25, 0xba, 0x32, 0x29, 0x02, 0xac, 0x73, 0xb5, 0x59,
+		0x22, 0x4a, 0xea, 0x43, 0x94, 0x29, 0x78, 0x1d, 0x0d, 0xca, 0xc8, 0xa6, 0x77, 0x9d, 0xfb, 0xe0,
+		0xfc, 0xd6, 0xf5, 0x97, 0xcc, 0xd5, 0x93, 0x87, 0xcc, 0x78, 0x7f, 0x37, 0xed, 0x59, 0xef, 0xf3,
+		0xef, 0x9c, 0x32, 0x32, 0x2d, 0x1b, 0x9f, 0x0a, 0x11, 0x25, 0xaf, 0x87, 0x7d, 0x1f, 0x8d, 0x47,
+		0xea, 0x48, 0xb1, 0x3c, 0x52, 0xea, 0x53, 0xb7, 0xd1, 0x7f, 0x8c, 0x4c, 0xa2, 0x8d, 0x12, 0x5e,
+		0x95, 0x76, 0xce, 0x27, 0xaa, 0x23, 0x68, 0xa7, 0xac, 0x6e, 0xec, 0x7f, 0xa9, 0x20, 0xc6, 0x8e,
+		0x0a, 0x35, 0x91, 0x00, 0x94, 0x92, 0xeb, 0xaa, 0x5d, 0x45, 0x95, 0xcf, 0x87, 0x31, 0xab, 0x6f,
+		0xed, 0xea, 0x1a, 0xb9, 0x6e, 0xc6, 0x29, 0x5e, 0x22, 0x95, 0x2a, 0x47, 0x14, 0xef, 0x22, 0x04,
+		0xfd, 0x50, 0x3a, 0x91, 0x3a, 0xe9, 0xa2, 0xc6, 0x2c, 0x93, 0xda, 0x6a, 0x9b, 0xb8, 0x6a, 0x0e,
+		0xe6, 0x0a, 0xbb, 0x91, 0x8c, 0x7f, 0x0c, 0x30, 0x51, 0x75, 0x9e, 0x7f, 0x1f, 0xbb, 0x00, 0x68,
+		0xe6, 0xd8, 0x1a, 0x76, 0xf7, 0x83, 0x6d, 0x09, 0x5d, 0x95, 0x4e, 0xd0, 0x8b, 0x91, 0x7d, 0x74,
+		0xdd, 0x71, 0x24, 0x49, 0x18, 0x1d, 0xf9, 0x8e, 0x7a, 0x2d, 0xca, 0x12, 0x24, 0x53, 0xcf, 0x1a,
+		0x11, 0x74, 0xf0, 0x21, 0xf9, 0x13, 0x6c, 0x7b, 0x94, 0xc3, 0xa7, 0xe3, 0x8b, 0x8f, 0xdc, 0x8b,
+		0x04, 0x58, 0xf4, 0x31, 0x7a, 0xea, 0x62, 0x8e, 0x3b, 0xf1, 0x2d, 0xcb, 0x5b, 0x0c, 0xd4, 0x9f,
+		0x44, 0x04, 0x5d, 0x72, 0x08, 0x86, 0xdc, 0xd9, 0x51, 0x18, 0x3c, 0x4a, 0x00, 0xba, 0xae, 0x29,
+		0xfe, 0xfb, 0x92, 0x08, 0x2c, 0xdd, 0xbf, 0xfb, 0x8a, 0x01, 0x2d, 0xfb, 0x11, 0x3d, 0xc9, 0x25,
+		0x53, 0x95, 0x8d, 0x8e, 0xe9, 0x1a, 0xe6, 0xfc, 0x78, 0xb3, 0x2c, 0x26, 0xf3, 0xc9, 0x74, 0x44,
+		0xe4, 0xab, 0x0f, 0xdc, 0x30, 0xa3, 0x4f, 0x2e, 0xf3, 0x5d, 0xc5, 0xab, 0xae, 0x5d, 0x98, 0x50,
+		0xf0, 0xd1, 0xd8, 0x2d, 0xa4, 0x22, 0x15, 0xc4, 0xef, 0x4b, 0xf5, 0xe7, 0x29, 0x7a, 0xbe, 0x47,
+		0x3b, 0x4f, 0x67, 0x74, 0x99, 0x18, 0x87, 0x9a, 0x80, 0x45, 0xb7, 0x44, 0x16, 0x06, 0xd3, 0xc9,
+		0x84, 0x96, 0xe4, 0x0e, 0xb8, 0x3e, 0x5d, 0xb9, 0xca, 0xd7, 0x74, 0x62, 0xd2, 0xa5, 0xe5, 0xd7,
+		0x22, 0x47, 0xde, 0xf5, 0xae, 0x98, 0xe4, 0xfb, 0x27, 0x33, 0x55, 0x1c, 0x57, 0x49, 0xea, 0x1c,
+		0x84, 0x4e, 0xec, 0x0f, 0x90, 0x7e, 0xe3, 0x76, 0x69, 0x4d, 0xdd, 0xc7, 0x6f, 0x9d, 0xe9, 0xa8,
+		0x54, 0x98, 0xa5, 0x0f, 0xdc, 0x9a, 0x8c, 0x9b, 0xe1, 0xad, 0xa6, 0x86, 0x86, 0xea, 0x04, 0xf5,
+		0x40, 0x8d, 0xbd, 0xe7, 0xfe, 0x54, 0x2f, 0x93, 0x09, 0x3d, 0x23, 0xea, 0xa9, 0x51, 0x6e, 0xea,
+		0x5d, 0x39, 0x64, 0x41, 0xaa, 0x84, 0xc5, 0x64, 0xd8, 0x98, 0x64, 0xaf, 0xe7, 0x32, 0x1b, 0xd1,
+		0xd4, 0x56, 0xa6, 0x78, 0x3a, 0x2d, 0x71, 0x23, 0x35, 0x10, 0x85, 0xd4, 0xd3, 0xec, 0xcc, 0xa3,
+		0x1a, 0x2b, 0xb9, 0xed, 0xb1, 0x9e, 0xb5, 0x4e, 0x9a, 0x94, 0xac, 0xd6, 0x48, 0x20, 0x23, 0x4d,
+		0xb3, 0xdd, 0xbe, 0xbc, 0x54, 0xbc, 0x23, 0xb7, 0x92, 0x41, 0x2d, 0xf4, 0x97, 0xcd, 0xd2, 0xf6,
+		0x6e, 0xfc, 0xd9, 0xf5, 0x72, 0x49, 0x53, 0x56, 0x58, 0xf5, 0xfc, 0x52, 0x3d, 0x9d, 0x3e, 0xca,
+		0x7f, 0xb9, 0xbb, 0xc8, 0xa7, 0x8f, 0x7c, 0x66, 0xa7, 0x25, 0xfe, 0x6e, 0x8b, 0x01, 0xd4, 0x83,
+		0xdf, 0x3e, 0xa1, 0xb6, 0xec, 0xf9, 0x71, 0xb2, 0xe4, 0x17, 0x42, 0x63, 0xdc, 0x0a, 0x59, 0xe4,
+		0xf9, 0xf8, 0x5a, 0x75, 0x9c, 0xbf, 0x8b, 0x51, 0xf7, 0x76, 0x30, 0xc9, 0x38, 0x80, 0xfe, 0x58,
+		0xb9, 0xfd, 0x29, 0x03, 0x72, 0xac, 0x4c, 0x46, 0x21, 0xf7, 0x99, 0xe2, 0x72, 0xda, 0xfc, 0x5c,
+		0x94, 0x4f, 0x83, 0x4c, 0x3a, 0x56, 0x5a, 0x18, 0x3f, 0xb5, 0x84, 0xc7, 0x5e, 0x7a, 0xfd, 0xb7,
+		0xf9, 0x95, 0xa0, 0xa5, 0xf3, 0xf1, 0x54, 0xf0, 0x91, 0x41, 0xb7, 0x0e, 0x41, 0x0e, 0x78, 0xed,
+		0xe8, 0x24, 0x00, 0xa2, 0x05, 0x7f, 0xdc, 0xac, 0x84, 0xce, 0x51, 0xfa, 0xfc, 0xe2, 0x2a, 0x3f,
+		0x04, 0xbf, 0xa0, 0x36, 0x92, 0x78, 0xc5, 0x35, 0xa5, 0x58, 0x7a, 0x74, 0xa1, 0x09, 0x0f, 0x2f,
+		0x01, 0x8b, 0xfc, 0x42, 0xf3, 0x61, 0x37, 0xe3, 0x5e, 0x93, 0xb3, 0xb4, 0xcd, 0x5d, 0x0c, 0x3c,
+		0x81, 0xd7, 0x61, 0x5f, 0xec, 0xd1, 0x77, 0x57, 0x1f, 0xca, 0x33, 0x72, 0x86, 0x94, 0x36, 0xea,
+		0x96, 0x4b, 0xdc, 0xfe, 0x0a, 0x72, 0x89, 0x3f, 0x48, 0x09, 0x84, 0x1d, 0x2d, 0x9c, 0xa2, 0x79,
+		0x76, 0x20, 0x79, 0x8d, 0x16, 0x08, 0x51, 0x99, 0xa5, 0x80, 0x71, 0x0e, 0x3d, 0x11, 0x51, 0xac,
+		0x7a, 0x0a, 0x7c, 0x30, 0x01, 0x92, 0xc0, 0x09, 0x86, 0x64, 0xa5, 0xb3, 0xb3, 0xe1, 0xe1, 0x5b,
+		0x5e, 0xf8, 0x8d, 0x81, 0x27, 0xe9, 0x6e, 0x6c, 0x8d, 0xd6, 0x7b, 0x1d, 0x4e, 0xed, 0xa5, 0xa0,
+		0x77, 0xa7, 0xd6, 0x51, 0x68, 0xec, 0x32, 0x1b, 0xf6, 0xd4, 0x7e, 0xd5, 0xd8, 0xcd, 0xc2, 0xeb,
+		0x50, 0x9f, 0xda, 0x8c, 0x6b, 0xd3, 0x34, 0x27, 0x37, 0xd0, 0x6b, 0x9e, 0x96, 0x11, 0x89, 0x35,
+		0x79, 0x3c, 0xa6, 0x4e, 0xed, 0x49, 0xdb, 0x77, 0xfa, 0x22, 0x99, 0x17, 0x9c, 0xe4, 0xe8, 0xf1,
+		0x3e, 0x3e, 0xb5, 0x01, 0xfb, 0x19, 0x1d, 0x3e, 0x67, 0x10, 0x6a, 0x92, 0x87, 0x75, 0x82, 0xcd,
+		0x0f, 0xd4, 0x4e, 0x6b, 0xb7, 0x3b, 0xf4, 0xc5, 0xaa, 0xf5, 0x75, 0x47, 0x95, 0x3c, 0x76, 0x84,
+		0xbe, 0xc3, 0x8b, 0xea, 0x13, 0x7c, 0x1c, 0x5a, 0x69, 0x81, 0xc8, 0x32, 0x72, 0x92, 0x03, 0xec,
+		0x6e, 0x77, 0xae, 0xfa, 0x0c, 0xa5, 0x14, 0x2e, 0x34, 0xe9, 0xc8, 0x9b, 0x05, 0xbf, 0x10, 0x38,
+		0x74, 0xf8, 0x0d, 0x45, 0x03, 0x02, 0x8a, 0x09, 0x48, 0x4a, 0x9d, 0x72, 0xa6, 0x8d, 0xb0, 0x42,
+		0xdf, 0x9b, 0x0d, 0x69, 0x72, 0x38, 0xd8, 0xe7, 0x8a, 0x5e, 0xc9, 0x03, 0xd8, 0x4e, 0xde, 0x3f,
+		0xe3, 0x9b, 0xa6, 0xd9, 0x04, 0xff, 0xdf, 0xc0, 0x04, 0xf8, 0x6c, 0xa9, 0x73, 0x01, 0x6c, 0xce,
+		0xeb, 0x1d, 0xce, 0xdb, 0x6a, 0x98, 0xa5, 0x48, 0xa7, 0xc5, 0x58, 0xcd, 0x45, 0x96, 0xdf, 0x1b,
+		0xc3, 0xbb, 0xf5, 0x90, 0x18, 0xe8, 0x2d, 0xc9, 0x7b, 0x1c, 0x80, 0x0b, 0x99, 0x4f, 0xe5, 0xa6,
+		0xff, 0xb8, 0xab, 0xe7, 0x12, 0xba, 0x3d, 0x1e, 0x61, 0x15, 0xa2, 0xe7, 0xd6, 0xd6, 0x69, 0x3d,
+		0x57, 0x5f, 0x3f, 0x7d, 0x3a, 0xe3, 0x6f, 0x3c, 0x56, 0x56, 0x6b, 0xf4, 0x3a, 0x7f, 0xe9, 0x17,
+		0x8e, 0x1f, 0x2e, 0xf3, 0xc1, 0x3c, 0xc3, 0x03, 0x85, 0xac, 0x92, 0x3f, 0x66, 0xb9, 0xdd, 0xc8,
+		0x49, 0x49, 0x64, 0xa4, 0x4c, 0xc1, 0x6f, 0x2c, 0x1e, 0x9d, 0x50, 0x12, 0x2d, 0xd7, 0xa9, 0x6d,
+		0xfa, 0xf9, 0x03, 0x45, 0xf0, 0x91, 0xb0, 0x25, 0x11, 0xff, 0xeb, 0xa7, 0x1f, 0xc6, 0xe6, 0xee,
+		0x42, 0x43, 0xad, 0xe8, 0x1d, 0x3d, 0xc5, 0xe6, 0x17, 0x17, 0x4f, 0x1e, 0x86, 0xb7, 0xd0, 0xfe,
+		0xe2, 0xc0, 0xf2, 0x6e, 0xb8, 0xf3, 0x66, 0x93, 0x87, 0x11, 0x2f, 0xcd, 0xfd, 0x1b, 0xef, 0x9b,
+		0x58, 0xca, 0x8c, 0x6a, 0x74, 0x0b, 0xf4, 0x7a, 0xe1, 0x5d, 0xa2, 0x87, 0x73, 0x49, 0x99, 0xa0,
+		0xa7, 0x2c, 0x06, 0x26, 0x57, 0x32, 0xe0, 0x39, 0x4c, 0x36, 0xf2, 0x24, 0x84, 0x9e, 0xe4, 0x27,
+		0x9e, 0x4e, 0x87, 0xf4, 0x5d, 0x3f, 0xca, 0xdf, 0xe0, 0xf5, 0xd2, 0x1c, 0x06, 0x2f, 0x27, 0xa6,
+		0x2e, 0x5d, 0xec, 0x78, 0x88, 0x42, 0xa3, 0x17, 0x49, 0xcb, 0x31, 0x51, 0xc7, 0x20, 0x64, 0xb1,
+		0xe7, 0x82, 0x37, 0x9d, 0xc6, 0xbf, 0x80, 0x24, 0x94, 0x07, 0x35, 0x81, 0xec, 0xc7, 0x81, 0xc5,
+		0x4f, 0xd6, 0x16, 0x6d, 0xa2, 0xed, 0x4f, 0xd1, 0xf8, 0x06, 0xb2, 0x5a, 0x79, 0x11, 0x26, 0xdb,
+		0x9a, 0x9e, 0x0f, 0x6b, 0x44, 0x77, 0xe5, 0x07, 0x2b, 0xba, 0x0f, 0x93, 0xfa, 0xfe, 0x24, 0xa0,
+		0xdb, 0xdc, 0x78, 0x47, 0xad, 0x10, 0x1e, 0x47, 0x86, 0x46, 0x0b, 0xd2, 0x7d, 0x9c, 0x18, 0x9f,
+		0x1c, 0x15, 0x9f, 0x37, 0x74, 0x5b, 0x6b, 0x50, 0x81, 0x6a, 0x9e, 0x16, 0xf1, 0xa8, 0x28, 0xcf,
+		0x2e, 0xe8, 0xc2, 0xbe, 0xc2, 0xf0, 0x0b, 0x14, 0xcd, 0xb3, 0x45, 0xde, 0xe3, 0x4a, 0x83, 0x3f,
+		0x13, 0x3f, 0x04, 0xa4, 0x2e, 0x11, 0x60, 0xc0, 0x89, 0xdb, 0x1b, 0x6a, 0x81, 0x25, 0x00, 0x1e,
+		0x01, 0x5c, 0x3c, 0x7a, 0xeb, 0x51, 0xe5, 0xbe, 0x79, 0x7d, 0x4d, 0x43, 0x12, 0x87, 0x5a, 0x05,
+		0x13, 0x2e, 0xb8, 0x5e, 0xe5, 0x3e, 0x4a, 0x2c, 0x60, 0x68, 0x3a, 0xa7, 0x7c, 0x55, 0x75, 0x01,
+		0xc7, 0x08, 0x70, 0xe4, 0x2a, 0x69, 0x9f, 0xc6, 0x9e, 0x1a, 0x47, 0x5a, 0xd1, 0xf7, 0xa1, 0xdc,
+		0x74, 0x92, 0x92, 0x39, 0xbf, 0xfd, 0x88, 0xba, 0x2b, 0xfa, 0xd0, 0x1c, 0x2a, 0x0b, 0x23, 0xc3,
+		0xbc, 0x0c, 0x8a, 0xfe, 0x02, 0xd9, 0x3b, 0x07, 0xd9, 0xf9, 0x09, 0x58, 0x10, 0x4a, 0x9d, 0xba,
+		0xb9, 0x55, 0xff, 0xe6, 0x39, 0x81, 0xcc, 0x31, 0xa1, 0xfd, 0xd2, 0x87, 0x71, 0x95, 0x96, 0xa9,
+		0x8e, 0xcc, 0x10, 0xe0, 0x8a, 0x4e, 0x2d, 0x74, 0x90, 0xd3, 0xf4, 0x38, 0x48, 0x00, 0x82, 0x46,
+		0x37, 0x79, 0x0f, 0xa5, 0x5d, 0xb2, 0x0d, 0xbb, 0x90, 0x0a, 0xa5, 0xa2, 0xf6, 0x00, 0x9a, 0x7f,
+		0xdc, 0xf0, 0xc8, 0x91, 0xe6, 0x73, 0x54, 0xac, 0x5f, 0xb4, 0x35, 0x9a, 0xa5, 0x1f, 0xf3, 0x13,
+		0xbb, 0xef, 0xce, 0x37, 0x8d, 0x4e, 0xb8, 0xb6, 0x55, 0x7f, 0xc2, 0xcb, 0x06, 0x0d, 0x60, 0x80,
+		0xe4, 0x93, 0xbe, 0x69, 0x7a, 0xd4, 0x6b, 0x88, 0x1d, 0x7a, 0x15, 0xf9, 0x6e, 0xd8, 0xec, 0xf3,
+		0x9a, 0x4d, 0x06, 0xc5, 0x20, 0x6f, 0x30, 0xe7, 0xd9, 0x99, 0x7a, 0x08, 0x26, 0xd7, 0x67, 0x81,
+		0x21, 0xce, 0x84, 0x45, 0x8d, 0x06, 0x42, 0x59, 0x38, 0x40, 0xe2, 0xcd, 0xa2, 0x43, 0xaa, 0x7b,
+		0x5b, 0xd0, 0x3c, 0x17, 0xff, 0x3b, 0xaf, 0x3c, 0x7d, 0x0d, 0x5e, 0xa0, 0x38, 0x2e, 0xfb, 0xf9,
+		0xc1, 0x08, 0xad, 0x8f, 0x86, 0x52, 0x9f, 0xfa, 0x79, 0x3f, 0xb8, 0xf7, 0xda, 0x09, 0x66, 0x28,
+		0xd3, 0x70, 0x88, 0x10, 0xb0, 0xfa, 0x81, 0x0e, 0x49, 0x8a, 0xe8, 0xe0, 0x97, 0x58, 0x1f, 0x7c,
+		0x50, 0x30, 0x6e, 0x4c, 0x45, 0xd5, 0x5f, 0xa6, 0x2e, 0xd9, 0xaa, 0x67, 0x3c, 0xa4, 0xab, 0x4d,
+		0xa9, 0xfe, 0x93, 0xb9, 0xe8, 0x06, 0x8f, 0xd3, 0x4d, 0xc7, 0xfd, 0x52, 0x4b, 0x8a, 0xe3, 0x5d,
+		0x82, 0xc2, 0x0a, 0x6b, 0xf0, 0x84, 0x49, 0x70, 0xcf, 0x75, 0x41, 0x28, 0x48, 0xcf, 0xf2, 0xec,
+		0x7f, 0xcb, 0x1c, 0x6f, 0x15, 0x85, 0x18, 0x00, 0x00,
+	}),
+	"/menu.js": embedded.NewFile("menu.js", time.Now(), 750, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x6c, 0x52, 0xbb, 0x6e, 0xdc, 0x30,
+		0x10, 0xec, 0xf9, 0x15, 0x73, 0x15, 0x4f, 0xc0, 0x41, 0xee, 0x4f, 0x50, 0x8a, 0x20, 0x0f, 0xb8,
+		0x48, 0x97, 0x2e, 0x48, 0xc1, 0x23, 0xd7, 0x10, 0x11, 0x3e, 0x14, 0x92, 0x82, 0x63, 0xd8, 0xfa,
+		0xf7, 0x80, 0x4b, 0x22, 0x77, 0x87, 0xb8, 0x13, 0x67, 0x76, 0x67, 0x77, 0x66, 0xa5, 0x63, 0xc8,
+		0x05, 0xaf, 0xf8, 0x46, 0x61, 0xc3, 0x8e, 0x19, 0x89, 0x7e, 0x6f, 0x36, 0xd1, 0x51, 0x92, 0x23,
+		0x5d, 0x52, 0x0c, 0x72, 0x10, 0xe2, 0xe1, 0x01, 0x1f, 0x37, 0xeb, 0x4c, 0x86, 0x0a, 0xf8, 0xdc,
+		0x09, 0xf8, 0xda, 0xf3, 0x94, 0xa2, 0x47, 0x59, 0x08, 0x5f, 0x23, 0x8b, 0x3c, 0x16, 0xf2, 0x30,
+		0x94, 0x75, 0xb2, 0x6b, 0xb1, 0x31, 0x8c, 0x90, 0xda, 0x59, 0xfd, 0x8b, 0x8c, 0x84, 0xcd, 0x55,
+		0x49, 0x2b, 0xe7, 0xc8, 0xe0, 0xd9, 0x96, 0x85, 0x1b, 0x6d, 0x21, 0x2f, 0x33, 0x1e, 0x3f, 0xe1,
+		0x79, 0xa1, 0x50, 0x27, 0x54, 0x04, 0x36, 0x23, 0xf3, 0x0e, 0x64, 0x46, 0xd1, 0xd6, 0xbc, 0xd4,
+		0x1d, 0xbe, 0x93, 0x5f, 0x9d, 0x2a, 0x84, 0x19, 0xc7, 0x5a, 0x98, 0x4f, 0xe8, 0x03, 0x06, 0xcc,
+		0x1f, 0xc0, 0xd0, 0xe8, 0xd5, 0x7a, 0x64, 0x96, 0xb1, 0x57, 0x01, 0x34, 0x05, 0x0a, 0x25, 0xbd,
+		0x60, 0x66, 0x04, 0xb0, 0xe6, 0xcc, 0xf5, 0xa3, 0x35, 0x27, 0x06, 0xca, 0xcb, 0x4a, 0x1d, 0xaa,
+		0x9f, 0x78, 0x7b, 0x83, 0x0c, 0x31, 0x79, 0xe5, 0x64, 0x2b, 0x70, 0xea, 0x42, 0xae, 0x57, 0xf0,
+		0x77, 0x83, 0x95, 0xd6, 0xe4, 0x28, 0xa9, 0x12, 0x53, 0x27, 0x6f, 0x90, 0x56, 0x42, 0x41, 0x5d,
+		0x1c, 0x99, 0x33, 0x0e, 0xcc, 0x1b, 0x9b, 0xf9, 0xdd, 0x48, 0xbd, 0x50, 0x4d, 0xe8, 0x8c, 0x43,
+		0x63, 0xfb, 0xbb, 0x92, 0xfb, 0x24, 0x00, 0xfb, 0xd4, 0xbc, 0x8e, 0x79, 0xbb, 0xd4, 0xd4, 0x87,
+		0x6e, 0x80, 0xed, 0xb4, 0x55, 0x67, 0xc8, 0x4e, 0xca, 0xe9, 0x86, 0xeb, 0x18, 0xe6, 0xfb, 0xf0,
+		0xee, 0xd4, 0xae, 0x01, 0xd6, 0xce, 0x1d, 0xe4, 0x32, 0xf1, 0xc8, 0x1b, 0xf9, 0xc3, 0x5c, 0x07,
+		0xd0, 0xaa, 0xd8, 0x92, 0xbc, 0x5f, 0x80, 0xdb, 0xeb, 0x3d, 0x38, 0xed, 0x2e, 0x76, 0xec, 0xc9,
+		0x36, 0x51, 0x01, 0x24, 0x2a, 0x5b, 0x0a, 0xad, 0x65, 0x12, 0xfb, 0x30, 0x09, 0xe1, 0xa3, 0xd9,
+		0x1c, 0x8d, 0xf4, 0x67, 0x8d, 0xa9, 0xe4, 0x7e, 0x17, 0x5e, 0xf4, 0xfc, 0xee, 0x71, 0xeb, 0x0f,
+		0x36, 0x32, 0xff, 0x25, 0x45, 0xff, 0xcf, 0xcc, 0xff, 0xd6, 0x72, 0x3d, 0xdd, 0x8f, 0x9f, 0xd7,
+		0xee, 0xe1, 0x24, 0xf6, 0x49, 0xfc, 0x1d, 0x00, 0xa7, 0x1f, 0x71, 0x25, 0xee, 0x02, 0x00, 0x00,
+	}),
+	"/notification.js": embedded.NewFile("notification.js", time.Now(), 1559, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x94, 0x54, 0x31, 0x6f, 0xdb, 0x3c,
+		0x10, 0xdd, 0xf5, 0x2b, 0xce, 0x13, 0x29, 0x40, 0xe0, 0xf4, 0xe1, 0x1b, 0x2c, 0xa8, 0x43, 0x91,
+		0x16, 0xf0, 0xd0, 0x0e, 0xed, 0x18, 0x64, 0x60, 0xa4, 0x53, 0x4c, 0x54, 0x22, 0x55, 0xf2, 0x94,
+		0xc4, 0x70, 0xf4, 0xdf, 0x0b, 0x52, 0x94, 0x42, 0x05, 0xb6, 0x8b, 0x2e, 0xb6, 0xc8, 0x7b, 0x7c,
+		0xf7, 0xee, 0xf1, 0x8e, 0xb5, 0xd1, 0x8e, 0xe0, 0x0c, 0xdf, 0x0d, 0xa9, 0x56, 0xd5, 0x92, 0x94,
+		0xd1, 0x05, 0x68, 0x49, 0xea, 0x19, 0x0f, 0xbd, 0x7c, 0x42, 0x98, 0xa0, 0x02, 0x8b, 0xbf, 0x47,
+		0x65, 0x91, 0x33, 0xec, 0xb0, 0x26, 0x6b, 0x34, 0xcb, 0xb3, 0xac, 0x37, 0xcd, 0xd8, 0xa1, 0xc0,
+		0xd7, 0xc1, 0x58, 0x72, 0x50, 0x01, 0x57, 0x46, 0xe7, 0x50, 0x7d, 0x82, 0x73, 0x06, 0x30, 0x13,
+		0xeb, 0x84, 0xd6, 0x43, 0x34, 0xbe, 0xc0, 0x37, 0x39, 0xf0, 0xbc, 0xcc, 0x32, 0x00, 0x65, 0xb4,
+		0xa8, 0x4d, 0xdf, 0x4b, 0xdd, 0xb8, 0x7b, 0x96, 0x62, 0x85, 0x3b, 0x9a, 0x17, 0xf6, 0xe0, 0x49,
+		0xa5, 0x7d, 0x72, 0x2b, 0x2b, 0x80, 0x6a, 0x81, 0xef, 0x52, 0xb5, 0x42, 0xb9, 0x9f, 0xe3, 0xe0,
+		0x35, 0x60, 0xc3, 0xf3, 0x3c, 0xc2, 0x00, 0xe8, 0x68, 0xcd, 0x4b, 0x48, 0xf8, 0xc5, 0x5a, 0x63,
+		0xf9, 0x86, 0xdf, 0x81, 0xb4, 0x08, 0xda, 0x10, 0xb8, 0xe5, 0x2c, 0x18, 0x0d, 0x74, 0x54, 0x0e,
+		0xdc, 0xc9, 0x11, 0xf6, 0x2c, 0x2f, 0x03, 0xd1, 0x14, 0x7e, 0xe7, 0x6a, 0xcc, 0xb0, 0xd4, 0xb1,
+		0x26, 0x51, 0xd4, 0xe1, 0x1e, 0xbc, 0x46, 0x11, 0xbe, 0xe1, 0xed, 0x0d, 0x18, 0x2b, 0x62, 0xf8,
+		0xd1, 0x34, 0xa7, 0x18, 0xf5, 0x9f, 0xdb, 0xa0, 0x53, 0x1d, 0x6a, 0xda, 0xc3, 0x6e, 0x17, 0x00,
+		0xf3, 0x72, 0x09, 0x1e, 0xa5, 0xfb, 0x81, 0x43, 0x77, 0x5a, 0xc3, 0xcb, 0xc6, 0x02, 0x90, 0x75,
+		0xd0, 0xb2, 0x9f, 0x0d, 0x12, 0x71, 0xe9, 0x33, 0xdc, 0x3f, 0xe4, 0xa2, 0x97, 0x03, 0xe7, 0x84,
+		0xaf, 0x14, 0x9c, 0xe3, 0x67, 0xa0, 0xd3, 0x80, 0x7b, 0x60, 0x8f, 0x23, 0x91, 0xd1, 0xac, 0x00,
+		0x1f, 0x83, 0x29, 0xcf, 0x67, 0xba, 0xa9, 0x5c, 0xbd, 0x0d, 0x6c, 0xaa, 0x36, 0xfa, 0xdd, 0xc9,
+		0x58, 0x76, 0xd8, 0x85, 0x2a, 0x6d, 0x0e, 0x51, 0x5b, 0x94, 0x84, 0x5f, 0xad, 0xe9, 0x3f, 0x8f,
+		0x6d, 0x8b, 0x96, 0xcf, 0x7f, 0xa2, 0xb5, 0xa6, 0x7f, 0xa7, 0x2a, 0x80, 0x3d, 0x4a, 0x87, 0xff,
+		0xff, 0xc7, 0xf2, 0x0b, 0xae, 0xa6, 0xf7, 0x72, 0xb8, 0x83, 0x6a, 0xf6, 0x4b, 0x35, 0xe5, 0x15,
+		0x4c, 0x6c, 0xa3, 0xb4, 0x07, 0x78, 0x94, 0x18, 0xd9, 0x53, 0xb4, 0x30, 0x9a, 0xb3, 0xd0, 0x4d,
+		0x05, 0xf0, 0xe0, 0x86, 0xef, 0x3a, 0xec, 0x15, 0x6d, 0x3b, 0x42, 0x78, 0x8c, 0x77, 0xe6, 0xbc,
+		0x49, 0x76, 0xb8, 0xf3, 0x2e, 0x5d, 0xa1, 0xad, 0x3b, 0x55, 0xff, 0xfa, 0x1b, 0x6f, 0x00, 0x61,
+		0xf3, 0x6f, 0xcc, 0xf3, 0x75, 0x7a, 0x6a, 0x7c, 0x46, 0x4d, 0x45, 0xbc, 0xee, 0x83, 0x6e, 0xf0,
+		0x35, 0x19, 0x06, 0xb8, 0x96, 0x74, 0x3d, 0xff, 0x31, 0xe7, 0x86, 0x09, 0xa6, 0x98, 0x7f, 0xf9,
+		0xdf, 0x70, 0x78, 0x1d, 0xd6, 0xb7, 0x5c, 0x22, 0x23, 0xac, 0x6f, 0x55, 0xeb, 0x01, 0xea, 0x62,
+		0xb5, 0xf1, 0xf0, 0x4d, 0x3b, 0x8d, 0xc3, 0xd5, 0xce, 0xa5, 0xc2, 0x14, 0xe6, 0x44, 0x83, 0x1d,
+		0x12, 0xf2, 0x74, 0xf3, 0x70, 0x97, 0x97, 0xb7, 0xdd, 0xa8, 0x3b, 0xe3, 0xae, 0xdd, 0xc0, 0x75,
+		0x03, 0x9c, 0x70, 0x48, 0x1f, 0x32, 0x15, 0x1b, 0xc4, 0x85, 0x43, 0xa1, 0x8d, 0xfc, 0xf3, 0x16,
+		0x86, 0xea, 0xf6, 0x1b, 0x17, 0x64, 0x5d, 0x7c, 0xe4, 0x2e, 0x37, 0x7d, 0xb2, 0x74, 0xe2, 0x09,
+		0x29, 0x0e, 0x58, 0x13, 0x65, 0xf8, 0xe9, 0xdd, 0x1c, 0xd9, 0x55, 0x15, 0x8c, 0xba, 0xc1, 0x56,
+		0x69, 0x6c, 0xde, 0xc7, 0x39, 0xc5, 0xcc, 0x1a, 0x78, 0x64, 0x98, 0x32, 0x80, 0xa9, 0xcc, 0xa6,
+		0x32, 0xfb, 0x33, 0x00, 0x38, 0xe6, 0xaf, 0x9c, 0x17, 0x06, 0x00, 0x00,
+	}),
+	"/open.js": embedded.NewFile("open.js", time.Now(), 1468, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xdb, 0x38,
+		0x10, 0xbd, 0xeb, 0x2b, 0xde, 0x21, 0x80, 0x28, 0xac, 0x42, 0xef, 0x5e, 0x6d, 0x38, 0x8b, 0x20,
+		0x09, 0x72, 0x09, 0xb0, 0xc1, 0x16, 0x45, 0x0f, 0x45, 0x81, 0x30, 0xd2, 0xc8, 0x22, 0x42, 0x91,
+		0x0a, 0x49, 0xd9, 0x0e, 0x1c, 0xff, 0x7b, 0x41, 0x4a, 0xb2, 0x65, 0xb7, 0x48, 0xd1, 0x5e, 0x2c,
+		0x0f, 0x39, 0x7c, 0x6f, 0xde, 0x9b, 0x99, 0xc2, 0x68, 0xe7, 0xb1, 0x83, 0x68, 0x5b, 0xec, 0xb1,
+		0x84, 0xa5, 0xd7, 0x4e, 0x5a, 0x62, 0x29, 0x29, 0x2a, 0xbc, 0x35, 0x3a, 0xcd, 0x92, 0x3e, 0xa7,
+		0x15, 0xbe, 0x9e, 0x26, 0x84, 0x38, 0xcd, 0x92, 0x64, 0x36, 0xc3, 0x2d, 0x29, 0xb9, 0x26, 0xeb,
+		0xf0, 0xf9, 0xff, 0x07, 0x07, 0xa1, 0x4b, 0x54, 0x52, 0x91, 0x83, 0xaf, 0x09, 0xee, 0xcd, 0x79,
+		0x6a, 0x20, 0xdc, 0x8b, 0x43, 0xe7, 0xe0, 0x0d, 0x4c, 0x4b, 0x3a, 0xc7, 0xa6, 0x26, 0x5f, 0x93,
+		0xc5, 0x5a, 0x0a, 0xd0, 0x9a, 0xb4, 0x77, 0x30, 0x36, 0x80, 0x85, 0x47, 0x85, 0x69, 0x9a, 0x00,
+		0xa3, 0xa4, 0x26, 0x8e, 0x6b, 0xfd, 0xe6, 0x6b, 0xa9, 0x57, 0x10, 0xd6, 0xca, 0x75, 0xf8, 0xf3,
+		0x4c, 0x95, 0xb1, 0x34, 0xa4, 0x6a, 0x4d, 0x85, 0x97, 0x46, 0x07, 0xec, 0x7b, 0x03, 0xe9, 0xd0,
+		0xb5, 0x90, 0x2e, 0x60, 0xbd, 0x76, 0xd4, 0x51, 0x89, 0xe7, 0x37, 0x48, 0xa3, 0xb9, 0x23, 0x5d,
+		0xf2, 0xa4, 0x31, 0x65, 0xa7, 0x88, 0xd3, 0xb6, 0x35, 0xd6, 0x3b, 0x2c, 0xc1, 0xa4, 0xd1, 0x19,
+		0x96, 0x57, 0xd8, 0x25, 0x08, 0x70, 0xce, 0xc3, 0x15, 0x35, 0x35, 0x34, 0x5e, 0xf2, 0xc2, 0xe8,
+		0x4a, 0xae, 0x78, 0x67, 0xd5, 0xa7, 0xe1, 0xe2, 0xfd, 0x1d, 0x5f, 0xbf, 0x65, 0xbc, 0x11, 0x2d,
+		0x63, 0x7d, 0x72, 0x44, 0x78, 0xba, 0xd8, 0xf5, 0x11, 0xf7, 0xe6, 0xc1, 0x6c, 0xc8, 0xde, 0x08,
+		0x47, 0x2c, 0xdb, 0xcf, 0x9f, 0xb2, 0xc5, 0x01, 0x9c, 0xb6, 0x9e, 0xb4, 0x93, 0x46, 0x9f, 0xe3,
+		0x07, 0xd3, 0xee, 0x8e, 0x97, 0x53, 0x0e, 0xda, 0xfa, 0x9e, 0x80, 0x5f, 0xec, 0x68, 0xeb, 0xcf,
+		0xe0, 0x03, 0x7a, 0x02, 0xcc, 0x66, 0xb8, 0x37, 0x68, 0x95, 0x28, 0xc8, 0x41, 0x06, 0x43, 0x37,
+		0x1a, 0xc2, 0xae, 0xba, 0x26, 0xda, 0x2b, 0x2a, 0x4f, 0x16, 0xe9, 0xe5, 0x65, 0xca, 0x13, 0x44,
+		0x43, 0xca, 0xbe, 0x6f, 0xd7, 0x76, 0x15, 0x4b, 0x11, 0x76, 0xb5, 0xce, 0xb1, 0x31, 0xf6, 0x45,
+		0xea, 0xd5, 0xad, 0xb4, 0x07, 0x53, 0xc6, 0xca, 0x25, 0x96, 0x01, 0x6f, 0xcd, 0xa5, 0x2e, 0x69,
+		0xfb, 0x5f, 0xc5, 0x02, 0x58, 0x54, 0x06, 0x30, 0x89, 0xab, 0x25, 0xfe, 0xc6, 0xbf, 0x7d, 0x86,
+		0x53, 0xb2, 0x20, 0x26, 0xf1, 0x17, 0xfe, 0xc9, 0x30, 0x8f, 0x42, 0x2a, 0x63, 0xef, 0x44, 0x51,
+		0x33, 0x26, 0xec, 0x6a, 0x02, 0x3d, 0x82, 0xab, 0x20, 0xa8, 0x27, 0x38, 0x95, 0xb7, 0x18, 0xd2,
+		0x64, 0x85, 0xc1, 0x6b, 0xc7, 0x9d, 0x69, 0xe8, 0xc4, 0xf9, 0xf8, 0x9a, 0x3b, 0x2f, 0xac, 0x77,
+		0x5f, 0xa4, 0xaf, 0xc7, 0xbb, 0x2c, 0x3b, 0xd0, 0xf4, 0x9a, 0xa9, 0x91, 0x9e, 0xa5, 0xa2, 0x6d,
+		0x79, 0x98, 0xc3, 0xcb, 0xce, 0xaa, 0x34, 0xc7, 0x0e, 0x9d, 0x55, 0xf3, 0x40, 0x8d, 0xfd, 0x81,
+		0x6f, 0x0f, 0x52, 0x8e, 0x22, 0xed, 0xb1, 0x65, 0x03, 0xf3, 0xd8, 0x8f, 0x9e, 0x96, 0x74, 0xd9,
+		0x93, 0x86, 0xe3, 0x5f, 0x31, 0x86, 0x2e, 0x47, 0xca, 0xb0, 0x40, 0xf3, 0xf8, 0xcb, 0x2d, 0x39,
+		0xa3, 0xd6, 0xc4, 0x8e, 0xde, 0xe7, 0xa1, 0x98, 0x6c, 0x5a, 0x4d, 0xfc, 0xf6, 0xf1, 0x3e, 0xb6,
+		0x3b, 0x6a, 0xd0, 0x2c, 0x9d, 0xe8, 0x60, 0x71, 0x93, 0xf2, 0xa0, 0x66, 0xe2, 0x70, 0x3c, 0xe4,
+		0xad, 0x8d, 0xdf, 0x5b, 0xaa, 0x44, 0xa7, 0xfc, 0x68, 0xeb, 0xc7, 0x96, 0x0c, 0xfc, 0xfb, 0xec,
+		0x47, 0xc2, 0x41, 0xc6, 0xc8, 0x18, 0xc2, 0x3f, 0xa7, 0x3c, 0xf3, 0x24, 0x84, 0xa7, 0xd4, 0x1f,
+		0xaf, 0xe1, 0x61, 0xb2, 0x26, 0x03, 0xd1, 0x77, 0x5d, 0x56, 0x27, 0x4f, 0x69, 0x4b, 0xc5, 0xa3,
+		0xf0, 0xf5, 0xb1, 0x45, 0x41, 0xb5, 0x23, 0x7f, 0xed, 0x86, 0x22, 0x1f, 0xad, 0xf1, 0xa6, 0x30,
+		0xea, 0x46, 0x49, 0xd2, 0x7e, 0x98, 0xa2, 0x1c, 0x3f, 0xc1, 0xc8, 0xc3, 0x50, 0x2f, 0x92, 0xc9,
+		0xa4, 0xfc, 0x16, 0xe6, 0xf8, 0xf4, 0xa8, 0xf1, 0x6c, 0x23, 0x59, 0x6b, 0x4d, 0x41, 0xce, 0xf1,
+		0xb0, 0x4f, 0x39, 0xc6, 0xa8, 0xd8, 0x94, 0x2c, 0xcb, 0x16, 0xc9, 0x7e, 0x91, 0x7c, 0x1f, 0x00,
+		0xf3, 0xcb, 0x03, 0x18, 0xbc, 0x05, 0x00, 0x00,
+	}),
+	"/power.js": embedded.NewFile("power.js", time.Now(), 1005, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xac, 0x50, 0x4b, 0x6b, 0xdb, 0x40,
+		0x10, 0xbe, 0xef, 0xaf, 0xf8, 0x0e, 0x05, 0xad, 0xc0, 0x56, 0x7a, 0x37, 0xee, 0xad, 0x87, 0x1e,
+		0x0a, 0x85, 0xe4, 0x16, 0x02, 0x59, 0x4b, 0x13, 0x6b, 0xe9, 0xee, 0x8c, 0xb3, 0x8f, 0xb8, 0xc6,
+		0xd1, 0x7f, 0x2f, 0x5a, 0xc9, 0xc6, 0x71, 0x5f, 0x04, 0x7a, 0x91, 0x76, 0x76, 0xbf, 0xe7, 0xb4,
+		0xc2, 0x31, 0xe1, 0x08, 0xb3, 0xdb, 0x61, 0xc0, 0x1a, 0x81, 0x9e, 0xb3, 0x0d, 0xa4, 0x2b, 0x72,
+		0xd4, 0xa6, 0x20, 0x5c, 0xd5, 0x4a, 0x4d, 0x20, 0x7a, 0x21, 0x4e, 0x11, 0x6b, 0xdc, 0x2b, 0xa0,
+		0x8a, 0x39, 0xee, 0x88, 0xbb, 0x6a, 0x31, 0x0e, 0x81, 0x62, 0xf6, 0x34, 0x9d, 0x85, 0x97, 0x1b,
+		0x93, 0x12, 0x85, 0xc3, 0x79, 0x36, 0xed, 0x74, 0x74, 0xd2, 0x7e, 0x5f, 0xc6, 0x36, 0x10, 0xf1,
+		0x74, 0x91, 0xf9, 0x97, 0xab, 0xd8, 0xe7, 0xd4, 0xc9, 0x7e, 0x9c, 0x1e, 0x56, 0x4a, 0x79, 0xe9,
+		0xb2, 0xa3, 0x86, 0x7e, 0xec, 0x24, 0x14, 0x73, 0x6d, 0x85, 0x6b, 0xac, 0x3f, 0xe1, 0xa8, 0x80,
+		0x9b, 0x1b, 0xdc, 0xf5, 0x84, 0x9d, 0xec, 0x29, 0x7c, 0x15, 0xb6, 0x49, 0x02, 0x26, 0x06, 0xbc,
+		0x39, 0x80, 0x25, 0x61, 0x43, 0xc8, 0x91, 0x3a, 0x64, 0x4e, 0xd6, 0x21, 0xf5, 0x54, 0xaa, 0xda,
+		0x88, 0x40, 0xa6, 0x3b, 0x34, 0x0a, 0x98, 0xda, 0xbd, 0xd1, 0x58, 0x43, 0x17, 0x8f, 0xdf, 0x6c,
+		0xa3, 0xb9, 0x04, 0xae, 0xc6, 0x04, 0x14, 0x9d, 0xe5, 0xb4, 0xec, 0x6c, 0x34, 0x1b, 0x47, 0x4b,
+		0x67, 0x99, 0xb0, 0x75, 0xb2, 0x31, 0x6e, 0x39, 0xf3, 0x95, 0xc2, 0xe8, 0xda, 0x08, 0xeb, 0xaa,
+		0xd8, 0x56, 0x8b, 0xd9, 0x60, 0x2c, 0x81, 0x79, 0xb3, 0xcd, 0x93, 0x84, 0xcf, 0xa6, 0xed, 0xb5,
+		0x66, 0xe3, 0xe9, 0xe2, 0x19, 0x6f, 0xc2, 0xe9, 0x7a, 0xd4, 0x19, 0x21, 0x27, 0x11, 0x2b, 0xdc,
+		0x90, 0xb7, 0x49, 0x3f, 0x16, 0x5c, 0xf3, 0xe1, 0x38, 0xbe, 0x0e, 0x8f, 0x75, 0xbd, 0x2a, 0xfc,
+		0xa1, 0xfc, 0xc7, 0xaf, 0x42, 0x01, 0xb7, 0xe2, 0xbd, 0xe1, 0x2e, 0xde, 0x57, 0x13, 0xc1, 0x76,
+		0x8e, 0xee, 0xac, 0xa7, 0xea, 0xe1, 0xdc, 0x7c, 0x0a, 0x66, 0x9f, 0xa0, 0xaf, 0xbc, 0xb7, 0x94,
+		0x6e, 0x0f, 0x31, 0x91, 0xff, 0x32, 0x93, 0xea, 0x73, 0xca, 0x40, 0x29, 0x07, 0xc6, 0xbf, 0x08,
+		0xfa, 0x14, 0x4b, 0x5d, 0x90, 0x98, 0xf6, 0xf8, 0x16, 0xc4, 0xdb, 0x48, 0x5a, 0x07, 0x8a, 0xe2,
+		0x5e, 0xa6, 0x15, 0x5c, 0xa9, 0x3d, 0x67, 0x0a, 0x87, 0x2b, 0xbd, 0x13, 0xbc, 0xe8, 0x0e, 0x7f,
+		0x6d, 0x79, 0x9b, 0x4c, 0x9a, 0x6b, 0x9a, 0xb0, 0x8d, 0xef, 0xa8, 0x5a, 0x98, 0xef, 0xea, 0x5a,
+		0x18, 0xc5, 0xa6, 0x49, 0x7d, 0xa0, 0xd8, 0x8b, 0xeb, 0xf0, 0xfa, 0x8a, 0x8f, 0xff, 0xb1, 0xff,
+		0x1f, 0x3d, 0x16, 0xb8, 0xde, 0xca, 0xb0, 0x52, 0x3f, 0x07, 0x00, 0x27, 0xb6, 0x07, 0x9a, 0xed,
+		0x03, 0x00, 0x00,
+	}),
+	"/preload.js": embedded.NewFile("preload.js", time.Now(), 611, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8c, 0x91, 0xc1, 0x6e, 0x14, 0x31,
+		0x0c, 0x86, 0xef, 0x79, 0x8a, 0xff, 0x36, 0x89, 0x34, 0xa4, 0x9c, 0xbb, 0xda, 0x22, 0x24, 0x28,
+		0x17, 0x0e, 0xa8, 0x02, 0x09, 0x8e, 0x61, 0xe2, 0xd9, 0x44, 0xca, 0x26, 0xc1, 0x71, 0x3b, 0x54,
+		0xab, 0x7d, 0x77, 0x94, 0xec, 0x52, 0xc1, 0x09, 0x4e, 0xe3, 0xf1, 0x78, 0x3e, 0x7f, 0xb6, 0x97,
+		0x92, 0x9b, 0xe0, 0x84, 0x58, 0x97, 0x07, 0xca, 0x9e, 0x98, 0x78, 0xc6, 0x46, 0xdf, 0xbf, 0x48,
+		0x4c, 0x0d, 0x67, 0xec, 0xc1, 0xf4, 0xe3, 0x31, 0x32, 0xe9, 0x89, 0x12, 0x2d, 0xc2, 0x25, 0x4f,
+		0x46, 0xa9, 0x9b, 0x1b, 0x3c, 0x50, 0x2d, 0x2c, 0x0d, 0x6b, 0x4c, 0xd4, 0xe0, 0xb9, 0xd4, 0x4a,
+		0x1e, 0x25, 0x4b, 0x81, 0x04, 0x42, 0x75, 0x07, 0xc2, 0x35, 0x3e, 0xba, 0x98, 0x51, 0xb9, 0x2c,
+		0xd4, 0xda, 0x8c, 0x2d, 0xc4, 0x25, 0x60, 0x2d, 0xbc, 0x39, 0xf6, 0xad, 0xa3, 0x24, 0xd0, 0xb1,
+		0xd7, 0x7e, 0x28, 0xd8, 0xa2, 0x84, 0xfe, 0x4f, 0x64, 0x30, 0xb9, 0x84, 0xea, 0x24, 0x34, 0xab,
+		0x2e, 0x9a, 0xbd, 0xd5, 0x27, 0x27, 0x01, 0x7b, 0xe8, 0x1e, 0x1b, 0xec, 0xef, 0xa0, 0x5f, 0x6c,
+		0xdf, 0xbc, 0x88, 0xdb, 0x03, 0x49, 0x2f, 0xbc, 0x2f, 0x7c, 0x1f, 0x13, 0x5d, 0x8b, 0x6f, 0x87,
+		0xab, 0xed, 0x48, 0xb3, 0x53, 0x6a, 0x8b, 0xd9, 0x97, 0xcd, 0x3a, 0xef, 0xdf, 0x3f, 0x51, 0x96,
+		0x8f, 0xb1, 0x09, 0x65, 0x62, 0x3d, 0x79, 0x76, 0x87, 0xf2, 0x44, 0x3c, 0xcd, 0xd0, 0xd4, 0x3f,
+		0x8d, 0x3e, 0x27, 0x05, 0x8c, 0x37, 0x5b, 0x79, 0x3c, 0xdf, 0xd1, 0xea, 0x1e, 0x93, 0x68, 0xb3,
+		0x53, 0xe7, 0x7f, 0xf0, 0x4a, 0xfd, 0x7f, 0x16, 0x70, 0x19, 0xb6, 0x6b, 0x36, 0xec, 0xf1, 0x96,
+		0xd9, 0x3d, 0xdb, 0x95, 0xcb, 0xf1, 0x22, 0x63, 0xbd, 0x13, 0xf7, 0x99, 0x5d, 0x6e, 0x2b, 0xb1,
+		0xed, 0x13, 0x35, 0x63, 0x8f, 0xae, 0xea, 0xdf, 0xdb, 0x31, 0x3d, 0x29, 0xc4, 0x5a, 0xd7, 0x21,
+		0x5e, 0x07, 0x34, 0xae, 0xd0, 0x03, 0x69, 0x13, 0xe5, 0x83, 0x04, 0xdc, 0xe1, 0xb5, 0x19, 0x22,
+		0xf8, 0xf3, 0xf8, 0xb6, 0x51, 0xf6, 0x7a, 0x8a, 0x25, 0x77, 0x0a, 0xb5, 0x57, 0xd7, 0xc3, 0x4e,
+		0x33, 0x4e, 0x97, 0x63, 0xcc, 0xf8, 0x79, 0x7b, 0x95, 0x5f, 0x52, 0xa4, 0x2c, 0x5f, 0x67, 0x3c,
+		0xff, 0x9d, 0xf9, 0x86, 0xbe, 0x10, 0xe0, 0xac, 0xce, 0x66, 0xa7, 0x7e, 0x0d, 0x00, 0x07, 0x20,
+		0xbd, 0x22, 0x63, 0x02, 0x00, 0x00,
+	}),
+	"/protocol.js": embedded.NewFile("protocol.js", time.Now(), 4937, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x9d, 0x58, 0x6d, 0x6f, 0xdb, 0x36,
+		0x10, 0xfe, 0x9e, 0x5f, 0x71, 0x03, 0x86, 0x4a, 0x46, 0x05, 0x35, 0x1d, 0xd6, 0x7d, 0x70, 0x90,
+		0x0e, 0x6d, 0xfa, 0x82, 0x02, 0x1d, 0x16, 0xac, 0x2d, 0xfa, 0x21, 0x08, 0x50, 0x5a, 0xa2, 0x6c,
+		0x2d, 0x12, 0xa9, 0x91, 0x54, 0x32, 0xc3, 0xd5, 0x7f, 0xdf, 0x1d, 0x5f, 0x24, 0xca, 0x76, 0xb2,
+		0xb6, 0x05, 0x8a, 0x9a, 0xe4, 0xe9, 0x5e, 0x9f, 0x7b, 0x8e, 0x6c, 0x21, 0x85, 0x36, 0xb0, 0x03,
+		0xd6, 0x75, 0x19, 0x74, 0x4a, 0x1a, 0x59, 0xc8, 0x26, 0x03, 0xcd, 0xb5, 0xae, 0xa5, 0x80, 0x01,
+		0xce, 0x41, 0xf1, 0x7f, 0xfa, 0x5a, 0xf1, 0x34, 0xe1, 0x0d, 0x2f, 0x8c, 0x92, 0x22, 0x59, 0x9c,
+		0x14, 0xf6, 0xb3, 0x4a, 0xc7, 0xc7, 0x95, 0x1e, 0x0f, 0x76, 0x70, 0xc9, 0xb4, 0xfe, 0xb8, 0x51,
+		0xb2, 0x5f, 0x6f, 0xe6, 0x4a, 0xb4, 0x51, 0x9c, 0xb5, 0x28, 0x79, 0xf2, 0xe4, 0x09, 0xfc, 0xc5,
+		0x4d, 0xaf, 0x84, 0x06, 0x46, 0xa6, 0xdb, 0x5a, 0x73, 0x90, 0x15, 0x98, 0x0d, 0x07, 0x54, 0x63,
+		0xb8, 0x30, 0xb4, 0x94, 0x82, 0x03, 0x5a, 0x6e, 0xfd, 0x92, 0x59, 0x55, 0x5c, 0x9b, 0x44, 0x43,
+		0xdf, 0x35, 0x92, 0x95, 0xaf, 0x98, 0x61, 0x39, 0x69, 0x7b, 0xed, 0xfd, 0x83, 0x0d, 0xc3, 0x33,
+		0xcd, 0x4b, 0x58, 0x49, 0xb3, 0x81, 0xa4, 0xaa, 0x1b, 0x9e, 0x00, 0x13, 0xa5, 0xfb, 0x79, 0xc9,
+		0xcc, 0x26, 0x81, 0x4a, 0x2a, 0x6b, 0xa9, 0xc3, 0x95, 0xd3, 0x4b, 0x67, 0xb9, 0x0f, 0x00, 0x7d,
+		0x2c, 0x3f, 0x8d, 0xda, 0xd1, 0xff, 0xb4, 0xc4, 0x7f, 0x17, 0x70, 0xfe, 0x1c, 0x76, 0x27, 0x00,
+		0x75, 0xe5, 0x36, 0xf2, 0xd5, 0xd6, 0x70, 0xbd, 0xb0, 0x7b, 0x80, 0x1f, 0x51, 0x34, 0x70, 0xe9,
+		0x42, 0xc9, 0x15, 0xd7, 0xb2, 0xb9, 0xe5, 0xb1, 0xe0, 0x19, 0xca, 0x0d, 0xf8, 0xd7, 0xa7, 0x0f,
+		0x0d, 0xa2, 0x6a, 0x7b, 0x6e, 0x7f, 0x7f, 0xfd, 0x3a, 0x2d, 0xc8, 0xcb, 0x33, 0x6f, 0x8a, 0xd6,
+		0x7b, 0x46, 0x04, 0xbf, 0x0b, 0x86, 0xd2, 0xd4, 0x5b, 0xca, 0xf0, 0xf0, 0x6f, 0x4c, 0xc1, 0xe8,
+		0x26, 0xfd, 0xa9, 0x74, 0x4e, 0xc1, 0xbc, 0x41, 0x15, 0x56, 0x4f, 0x06, 0x29, 0x57, 0x2a, 0x0b,
+		0x19, 0x9e, 0xc9, 0x3a, 0x6b, 0x78, 0xbc, 0x88, 0xb6, 0xc0, 0x6b, 0xb5, 0xfb, 0x67, 0xe3, 0xf6,
+		0x80, 0x45, 0xc1, 0x7a, 0x8d, 0x99, 0x90, 0x55, 0xa5, 0xb9, 0x19, 0x43, 0x68, 0xb8, 0x58, 0x63,
+		0x62, 0x9f, 0xc3, 0xe9, 0x5c, 0x97, 0x8b, 0x5c, 0x1b, 0xa6, 0x4c, 0x08, 0x7d, 0xfa, 0xf2, 0xf4,
+		0x6c, 0x66, 0xd5, 0xa5, 0xcf, 0x3b, 0x9a, 0xeb, 0xa6, 0x2e, 0x78, 0x6a, 0xbf, 0xcc, 0xf6, 0x6d,
+		0xc0, 0xef, 0x5e, 0xe5, 0xe3, 0xd9, 0xc9, 0x12, 0x7a, 0x51, 0xf2, 0xaa, 0x16, 0xbc, 0x5c, 0x1c,
+		0xba, 0xbe, 0xbb, 0xdf, 0x58, 0x2c, 0xec, 0x7f, 0x0d, 0x7e, 0x6f, 0x18, 0x8b, 0x38, 0x81, 0xa0,
+		0x91, 0xab, 0x4f, 0x9f, 0xde, 0xbd, 0x0a, 0x91, 0x22, 0x14, 0xff, 0x6c, 0x4a, 0xae, 0xe0, 0x96,
+		0x2b, 0xea, 0x22, 0x4d, 0xf8, 0x1a, 0xc1, 0x69, 0xd8, 0x0d, 0x47, 0xb4, 0x15, 0xac, 0x69, 0x56,
+		0xac, 0xb8, 0x01, 0x85, 0x85, 0xe6, 0x84, 0x45, 0x26, 0x7c, 0x71, 0x6b, 0xb1, 0x06, 0x16, 0x34,
+		0xf9, 0xc6, 0xc8, 0x7f, 0xa8, 0xf8, 0x01, 0xcc, 0xba, 0x6f, 0x28, 0xdd, 0xbe, 0xab, 0x73, 0xcc,
+		0x09, 0xc3, 0x9d, 0x0f, 0x7e, 0xb9, 0xe6, 0xe6, 0x25, 0x86, 0x40, 0x48, 0x9f, 0x07, 0x94, 0x85,
+		0xbc, 0x8c, 0xf9, 0xa0, 0x98, 0xbd, 0xba, 0x47, 0x8f, 0xbc, 0xe2, 0x1c, 0xfd, 0x17, 0x71, 0x95,
+		0xa3, 0xed, 0x03, 0xef, 0x82, 0xa2, 0x61, 0x2f, 0x99, 0xce, 0x53, 0x84, 0x18, 0xba, 0x49, 0xe1,
+		0xbd, 0x56, 0x4a, 0xaa, 0x34, 0xe9, 0x85, 0xee, 0xbb, 0x4e, 0x2a, 0x83, 0xcd, 0xec, 0xba, 0xdd,
+		0x16, 0x38, 0xb1, 0xdf, 0xa1, 0x74, 0x8e, 0x65, 0x37, 0xbd, 0xbe, 0x90, 0x25, 0x75, 0xd2, 0xb3,
+		0xd3, 0xa7, 0xb4, 0x7f, 0xd0, 0x87, 0x11, 0x7c, 0x87, 0x33, 0x4b, 0x3c, 0x17, 0xb2, 0xed, 0x1a,
+		0x8e, 0x2d, 0x39, 0xf1, 0x09, 0x55, 0xc0, 0xa0, 0x1f, 0x7d, 0x53, 0x82, 0x90, 0x06, 0x56, 0x1c,
+		0x4f, 0x1a, 0xb6, 0x45, 0xcb, 0x46, 0xc2, 0x5b, 0x19, 0xa8, 0xa1, 0x62, 0x75, 0x43, 0x84, 0x10,
+		0x0a, 0x98, 0xc1, 0xe4, 0xc3, 0x98, 0xfc, 0x00, 0x73, 0xe2, 0x3a, 0x1f, 0x51, 0xc4, 0x88, 0xa9,
+		0x75, 0xdf, 0x9d, 0xe6, 0x5c, 0x94, 0x6e, 0x1d, 0x14, 0xa6, 0xbb, 0x48, 0x63, 0x06, 0x1b, 0xec,
+		0x5e, 0xc4, 0xd1, 0x12, 0x76, 0x83, 0xc3, 0xfd, 0x32, 0xe8, 0x1d, 0xa6, 0x78, 0x3e, 0x70, 0x75,
+		0x8b, 0xc1, 0xf8, 0x50, 0x34, 0xb4, 0xf8, 0x0d, 0xb9, 0xed, 0xb9, 0xb4, 0xaa, 0xd7, 0xbd, 0xc2,
+		0x40, 0x74, 0xb1, 0x41, 0x22, 0x85, 0xd5, 0xd6, 0x85, 0x46, 0x40, 0x43, 0x89, 0x36, 0x04, 0xd8,
+		0xca, 0xb2, 0x47, 0x0e, 0xe4, 0xff, 0x52, 0xc6, 0x89, 0xdb, 0x53, 0x84, 0xc7, 0x5e, 0x4c, 0xbb,
+		0xa0, 0x84, 0x58, 0x9d, 0xd0, 0xe3, 0xd4, 0x07, 0xaa, 0xfa, 0xc9, 0x9d, 0xce, 0xd9, 0xca, 0x15,
+		0xf9, 0xc4, 0xe2, 0xf9, 0x52, 0xd5, 0xb7, 0x48, 0x43, 0xeb, 0xd1, 0x1b, 0x74, 0xb6, 0xd7, 0x3e,
+		0xdd, 0xeb, 0x5a, 0x1b, 0x4e, 0x8e, 0xae, 0x38, 0xf2, 0x33, 0xb7, 0xee, 0xe3, 0x64, 0x82, 0x5a,
+		0x5b, 0x46, 0xde, 0xe6, 0xde, 0x4a, 0x18, 0x54, 0x79, 0xf8, 0xe4, 0x83, 0x53, 0xf5, 0x42, 0x4f,
+		0xea, 0x83, 0x0b, 0xdf, 0x22, 0x9b, 0x5e, 0x05, 0xf4, 0x3a, 0x9f, 0x32, 0xbf, 0xea, 0x82, 0x04,
+		0xa5, 0x7f, 0xc4, 0x37, 0x56, 0x47, 0x94, 0x4c, 0x95, 0x4b, 0x30, 0xaa, 0x1f, 0x65, 0x71, 0x9f,
+		0x17, 0x98, 0xe5, 0x83, 0x5d, 0x07, 0xe0, 0x37, 0xdc, 0x14, 0x9b, 0x17, 0x97, 0xef, 0xf6, 0x8f,
+		0x0b, 0xa9, 0xf4, 0x6b, 0xc1, 0x56, 0x0d, 0x3f, 0xd4, 0x67, 0xcb, 0x3c, 0xdf, 0x1d, 0xdc, 0x8f,
+		0xe1, 0xda, 0x75, 0x4e, 0xcc, 0x64, 0x87, 0x81, 0x7a, 0x3f, 0x7d, 0xc0, 0xe9, 0x95, 0x0b, 0xee,
+		0x3a, 0xa3, 0x22, 0x46, 0xbe, 0x8e, 0x6d, 0x78, 0x12, 0x31, 0x46, 0x87, 0x3f, 0xb8, 0xf6, 0xd8,
+		0xfd, 0x83, 0x75, 0x0e, 0xa3, 0xd8, 0x33, 0xd0, 0x30, 0x6d, 0xde, 0xbd, 0xc2, 0x13, 0xa4, 0xeb,
+		0xf1, 0x8b, 0x46, 0xca, 0x9b, 0xbe, 0xb3, 0x90, 0x29, 0x23, 0x0a, 0x9a, 0xab, 0xb3, 0xf7, 0x00,
+		0xaf, 0x99, 0x58, 0x87, 0x64, 0x1d, 0x21, 0x78, 0x5e, 0xf1, 0x52, 0xe7, 0xe7, 0x11, 0x71, 0x8f,
+		0x89, 0x37, 0xd8, 0x3c, 0x77, 0x11, 0x39, 0x7c, 0xe9, 0xc5, 0x8d, 0x90, 0x77, 0x62, 0x0c, 0x3c,
+		0xc0, 0x7f, 0x09, 0x3f, 0xef, 0xea, 0x72, 0xf8, 0x12, 0x18, 0x3b, 0xa6, 0xce, 0x60, 0xc4, 0x06,
+		0x6c, 0xfd, 0x47, 0x7c, 0xe5, 0x52, 0xa4, 0x89, 0x05, 0x58, 0x82, 0xe3, 0x31, 0x72, 0xff, 0x48,
+		0x4a, 0xa9, 0x24, 0x97, 0x7e, 0x3b, 0xf5, 0x68, 0x21, 0xdf, 0xad, 0xe5, 0x6c, 0x6c, 0xe2, 0x19,
+		0x0d, 0xfb, 0x8c, 0x3d, 0x3e, 0x87, 0xa7, 0x81, 0xff, 0x5c, 0x62, 0xea, 0x12, 0x53, 0xe2, 0x4e,
+		0xc3, 0x01, 0xb6, 0xc8, 0x47, 0x44, 0xfd, 0x4a, 0x96, 0xdb, 0x00, 0x7b, 0xa8, 0x05, 0x54, 0x7d,
+		0xd3, 0xc4, 0x5d, 0x11, 0x48, 0xcb, 0x8a, 0x58, 0x96, 0x42, 0x1e, 0x92, 0x8e, 0xc3, 0xde, 0xca,
+		0x49, 0x97, 0xe0, 0x38, 0x84, 0xb0, 0xda, 0x96, 0xe9, 0x3a, 0x1c, 0x91, 0x35, 0x6b, 0xe8, 0x4a,
+		0x95, 0x7b, 0x91, 0x40, 0x92, 0xe8, 0x76, 0x1a, 0xa2, 0xc8, 0xa7, 0x7b, 0x15, 0x4d, 0xe5, 0xab,
+		0xeb, 0x45, 0xde, 0x22, 0x00, 0xe6, 0x77, 0xa2, 0xc5, 0xc2, 0x11, 0x7c, 0x4a, 0x4a, 0xf5, 0xde,
+		0x35, 0x62, 0xaa, 0xb2, 0xb6, 0x55, 0x26, 0xc4, 0xc5, 0x7c, 0xe9, 0x70, 0x2d, 0x28, 0xa6, 0x21,
+		0x9a, 0xb5, 0x44, 0x27, 0x9a, 0xf8, 0x70, 0x76, 0x63, 0x68, 0xb1, 0x31, 0x92, 0xa8, 0x12, 0xee,
+		0xf6, 0x97, 0x45, 0x22, 0x4c, 0xad, 0x67, 0x0d, 0x6a, 0x75, 0x95, 0xd9, 0x6c, 0xdd, 0x72, 0xb3,
+		0x91, 0xa8, 0x29, 0xc4, 0xe8, 0xd6, 0x73, 0x99, 0x5e, 0x35, 0x93, 0x00, 0x2e, 0xe6, 0xa7, 0x23,
+		0x11, 0x07, 0x09, 0xbf, 0x31, 0x97, 0xa2, 0xc2, 0x2d, 0xe1, 0x65, 0x5f, 0x55, 0x5c, 0x11, 0x37,
+		0x16, 0xcc, 0xf8, 0x14, 0xe5, 0x46, 0x22, 0x7c, 0x90, 0x73, 0xd3, 0x64, 0xc5, 0x34, 0xff, 0xed,
+		0xd7, 0x64, 0x11, 0x7f, 0x3a, 0x4c, 0x8b, 0x29, 0x27, 0x43, 0xe6, 0xef, 0x63, 0xb3, 0xf4, 0x12,
+		0x78, 0x24, 0x11, 0xb5, 0xed, 0x83, 0xf9, 0xbd, 0x8c, 0xc6, 0x53, 0x34, 0x9b, 0xf6, 0x66, 0x24,
+		0x96, 0xf3, 0xd9, 0xe9, 0xe9, 0xa4, 0x3e, 0xb4, 0xc8, 0x11, 0x33, 0x47, 0xae, 0x82, 0xf7, 0xdb,
+		0x9d, 0x8d, 0xf3, 0x85, 0xed, 0x2b, 0x37, 0x1b, 0xda, 0x16, 0x39, 0x48, 0x5f, 0xc5, 0xf5, 0x23,
+		0x68, 0x94, 0xc9, 0x35, 0x71, 0x05, 0x15, 0xee, 0x21, 0xb6, 0x70, 0xac, 0x62, 0xc5, 0xf2, 0x91,
+		0x2b, 0xc2, 0x79, 0xfe, 0xf0, 0x78, 0x8d, 0x04, 0x8f, 0x4e, 0xd6, 0xa5, 0x85, 0x8d, 0x4f, 0x4e,
+		0x34, 0x66, 0xed, 0xae, 0x5f, 0x51, 0xbe, 0xa6, 0xb1, 0xbb, 0x6f, 0xd8, 0x87, 0x7b, 0xe6, 0x27,
+		0xdb, 0x67, 0x56, 0xe3, 0xd0, 0x0c, 0xef, 0x0a, 0xfa, 0x84, 0xe6, 0x2a, 0xce, 0x35, 0x56, 0x14,
+		0xbc, 0x33, 0xf3, 0x0e, 0x85, 0x52, 0x62, 0x4b, 0xd2, 0x3d, 0x43, 0xf6, 0xa6, 0x63, 0x85, 0xed,
+		0x6a, 0xa7, 0x87, 0xb2, 0xd0, 0xb7, 0x88, 0x1e, 0x78, 0x83, 0xb5, 0xd4, 0x54, 0x08, 0x52, 0xe8,
+		0x8d, 0x16, 0x8d, 0x24, 0x5e, 0xae, 0x6a, 0x45, 0x74, 0xa3, 0xfb, 0x62, 0x03, 0xf8, 0xda, 0xb9,
+		0xc3, 0x56, 0xf4, 0xcf, 0x99, 0x35, 0xc7, 0xe9, 0x7f, 0x83, 0x30, 0x73, 0xda, 0x62, 0xb2, 0x58,
+		0x93, 0x4d, 0x76, 0xc7, 0xb6, 0x33, 0x4f, 0xb4, 0x91, 0x1d, 0x6a, 0x50, 0xb5, 0xc1, 0x8f, 0xf2,
+		0xfb, 0x2b, 0x47, 0x12, 0xfc, 0x81, 0xba, 0xed, 0xc6, 0x7b, 0xc9, 0x7d, 0x95, 0x23, 0x48, 0xf9,
+		0x5b, 0x4f, 0x89, 0xfe, 0x28, 0xb9, 0x7d, 0x90, 0xdf, 0xf7, 0x79, 0x1d, 0x69, 0xdd, 0xeb, 0x1b,
+		0xe0, 0x0e, 0x83, 0xb6, 0xa9, 0x28, 0xe7, 0x34, 0x1f, 0x99, 0xb0, 0xfe, 0xa6, 0xbe, 0x13, 0x2b,
+		0xe4, 0x39, 0xe7, 0x4d, 0xe9, 0x69, 0x2d, 0x41, 0xae, 0x1f, 0x1b, 0x71, 0x31, 0xb9, 0x11, 0xee,
+		0xd8, 0x48, 0x4e, 0x47, 0xe6, 0xc7, 0x77, 0x5e, 0xbd, 0xa5, 0xb8, 0x20, 0x27, 0x29, 0x67, 0xf6,
+		0xb4, 0x44, 0xea, 0x4d, 0x7f, 0x20, 0xc4, 0x45, 0x34, 0x1e, 0x2e, 0x10, 0xcd, 0x78, 0x45, 0xba,
+		0xab, 0x91, 0xc0, 0x7a, 0x83, 0xef, 0x5a, 0x82, 0x6c, 0xef, 0xde, 0xc7, 0x02, 0x92, 0x52, 0xb1,
+		0x5a, 0x60, 0x6c, 0xf4, 0xde, 0x25, 0x19, 0xfb, 0x80, 0xa6, 0x03, 0xdb, 0xb5, 0x49, 0x3e, 0xf3,
+		0x8f, 0xfc, 0x21, 0xe7, 0x0e, 0x39, 0xc6, 0x27, 0x51, 0xf1, 0x56, 0xde, 0xf2, 0xf7, 0x34, 0xfc,
+		0x04, 0xc7, 0x0b, 0x79, 0xd0, 0x4e, 0x1f, 0x46, 0xac, 0x73, 0x8f, 0xb4, 0x33, 0xf9, 0xad, 0xd2,
+		0x36, 0x58, 0x94, 0xf6, 0x49, 0x8b, 0x07, 0xc3, 0xf7, 0x3e, 0x4b, 0x8f, 0xbd, 0xed, 0xa8, 0xa2,
+		0xc7, 0x1e, 0x76, 0x61, 0xcb, 0x7b, 0x45, 0x77, 0x81, 0xa3, 0x51, 0x46, 0xe7, 0x47, 0xe3, 0x8a,
+		0xce, 0x8f, 0x47, 0xb2, 0xc7, 0x17, 0xf3, 0x37, 0x88, 0xa7, 0x16, 0xf8, 0x4c, 0x9d, 0x9c, 0xb0,
+		0x95, 0x7d, 0xf3, 0x24, 0x34, 0xdf, 0x71, 0x84, 0x66, 0xd4, 0xa7, 0xc4, 0xec, 0x58, 0x77, 0x1a,
+		0x26, 0x88, 0x8d, 0xad, 0xed, 0x17, 0x64, 0x3b, 0xa7, 0x8c, 0xaa, 0x1d, 0xb7, 0x7a, 0x8b, 0x02,
+		0xac, 0xb1, 0x77, 0x1a, 0xd8, 0xb0, 0x5b, 0xbc, 0x50, 0x70, 0xd4, 0x5b, 0x78, 0x93, 0xe5, 0x03,
+		0x6d, 0xce, 0xbf, 0x95, 0x9c, 0x2d, 0x50, 0xbd, 0xa3, 0xf8, 0x00, 0x9f, 0xdf, 0xec, 0x42, 0xe3,
+		0xe3, 0x1b, 0xfc, 0x5e, 0x2a, 0xf8, 0xbf, 0x0b, 0xdf, 0xf4, 0x78, 0x98, 0x9a, 0x30, 0xd8, 0x28,
+		0x39, 0xc5, 0xf1, 0x80, 0xd2, 0x71, 0x3a, 0xa0, 0x6e, 0x5b, 0xf9, 0xe9, 0x7f, 0x44, 0x68, 0x42,
+		0x1e, 0xcc, 0x86, 0x0c, 0x07, 0xe3, 0x2f, 0xa1, 0x50, 0x73, 0x10, 0xed, 0xa9, 0x9c, 0x5e, 0x6c,
+		0xce, 0xad, 0xc1, 0xbe, 0xc2, 0xfe, 0x03, 0x36, 0x7e, 0x77, 0xe2, 0x49, 0x13, 0x00, 0x00,
+	}),
+	"/screen.js": embedded.NewFile("screen.js", time.Now(), 1184, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xa4, 0x53, 0xc1, 0x8a, 0xdb, 0x30,
+		0x10, 0xbd, 0xfb, 0x2b, 0xe6, 0x66, 0x19, 0x6c, 0xed, 0xbd, 0xc1, 0x85, 0xa5, 0x4b, 0x61, 0x0f,
+		0x85, 0x85, 0xf6, 0x56, 0x7a, 0x90, 0xa5, 0x21, 0x19, 0x2a, 0x4b, 0xee, 0x48, 0xde, 0x36, 0x84,
+		0xfc, 0x7b, 0x91, 0x25, 0xc7, 0xd9, 0x6d, 0x96, 0x42, 0x7b, 0x0a, 0x99, 0xf7, 0xde, 0xbc, 0x37,
+		0x23, 0x8f, 0xf6, 0x2e, 0x44, 0x38, 0x81, 0x9a, 0x26, 0x38, 0x43, 0x0f, 0x8c, 0x3f, 0x66, 0x62,
+		0x14, 0x35, 0x5a, 0xd4, 0x91, 0xbd, 0xab, 0x9b, 0xaa, 0x1a, 0xbd, 0x99, 0x2d, 0x4a, 0xfc, 0x35,
+		0x79, 0x8e, 0x01, 0x7a, 0x10, 0xe4, 0x5d, 0x03, 0xfd, 0x7b, 0x38, 0x55, 0x00, 0x77, 0x77, 0xf0,
+		0xe5, 0x80, 0x10, 0x34, 0x23, 0x3a, 0xc8, 0x5c, 0x18, 0xd5, 0x11, 0x9c, 0x8f, 0x30, 0x20, 0xcc,
+		0x01, 0x0d, 0xcc, 0x2e, 0x92, 0x85, 0x78, 0xc0, 0xc5, 0x89, 0x02, 0x30, 0x2a, 0x73, 0x94, 0x15,
+		0x40, 0x4e, 0x50, 0xd4, 0x3d, 0x88, 0xa5, 0xef, 0x8d, 0x18, 0x32, 0x53, 0x76, 0xc9, 0x0f, 0x83,
+		0x25, 0x17, 0x3b, 0x43, 0x41, 0x0d, 0x16, 0x3b, 0x4b, 0x0e, 0x61, 0x6f, 0xfd, 0xa0, 0x6c, 0x57,
+		0x94, 0xd5, 0xa5, 0xb3, 0xc1, 0xa0, 0x99, 0x06, 0x4c, 0xb1, 0x0d, 0x85, 0xc9, 0xaa, 0x63, 0x0b,
+		0x13, 0xd3, 0xa8, 0xf8, 0xf8, 0xf8, 0xb0, 0xb8, 0x89, 0x34, 0x06, 0x00, 0x99, 0x77, 0x50, 0x18,
+		0x92, 0x4c, 0xbb, 0xd4, 0x06, 0x3f, 0x3b, 0x13, 0xb6, 0x7a, 0xfe, 0x9f, 0xb1, 0x9f, 0x9e, 0xbf,
+		0xdf, 0x33, 0xaa, 0x0d, 0x5d, 0x2b, 0x19, 0x0f, 0x5a, 0x59, 0xfc, 0xa8, 0x74, 0xf4, 0xbc, 0x51,
+		0xae, 0x8a, 0x99, 0xc5, 0x3e, 0xaa, 0x48, 0xde, 0x6d, 0x94, 0xb5, 0x92, 0xf1, 0x12, 0x75, 0x83,
+		0xc9, 0x40, 0xdf, 0xf7, 0xdb, 0x08, 0x89, 0x76, 0x6e, 0x76, 0x69, 0x62, 0x35, 0x4d, 0xd2, 0x3b,
+		0x51, 0x2f, 0xcb, 0xad, 0xdb, 0xb2, 0xcc, 0x53, 0x49, 0x93, 0xd6, 0x27, 0x9a, 0x85, 0x50, 0x7a,
+		0x75, 0xca, 0x18, 0x34, 0x89, 0x88, 0xcf, 0xe8, 0x62, 0xbb, 0x7a, 0x5c, 0xc9, 0x00, 0xc8, 0x3b,
+		0x89, 0x23, 0xc5, 0x8b, 0x4a, 0xae, 0xaa, 0xd3, 0xca, 0x7f, 0x7c, 0x78, 0x11, 0x2f, 0xa5, 0x49,
+		0xd2, 0xf5, 0xf7, 0xa6, 0x35, 0xe3, 0xe8, 0x9f, 0xff, 0xc1, 0x7c, 0xd3, 0xfd, 0x97, 0xfd, 0x88,
+		0x91, 0x49, 0x87, 0x4e, 0x1f, 0x94, 0xdb, 0xdf, 0x8a, 0xd1, 0x42, 0x81, 0x3e, 0x65, 0xe6, 0x5f,
+		0x62, 0xfd, 0xd9, 0xef, 0x8d, 0x78, 0xaf, 0xfb, 0xbe, 0x8a, 0x5b, 0x5e, 0x32, 0x2d, 0x5d, 0xfb,
+		0x71, 0x54, 0xce, 0x84, 0xaf, 0x75, 0x9e, 0x40, 0x96, 0x26, 0xa1, 0xfe, 0x76, 0x39, 0x94, 0xfc,
+		0xb6, 0xf9, 0x86, 0x2e, 0x5f, 0x04, 0xf4, 0xdb, 0xcc, 0x7b, 0x8c, 0x4f, 0xb9, 0xfe, 0x90, 0xd5,
+		0xa2, 0x91, 0x64, 0xf2, 0x62, 0x18, 0xe3, 0xcc, 0xee, 0x05, 0xf7, 0xde, 0xda, 0xc2, 0x0b, 0xa2,
+		0x91, 0xa3, 0x9a, 0xc4, 0x7a, 0x34, 0x8b, 0xdd, 0x7a, 0x4c, 0xb7, 0x2e, 0x29, 0xc7, 0x7f, 0x3b,
+		0xbd, 0x9e, 0x39, 0x78, 0xbe, 0xca, 0x7e, 0xed, 0xfb, 0x61, 0x01, 0x3f, 0x2f, 0x95, 0x27, 0x4f,
+		0x2e, 0x8a, 0x66, 0x57, 0x9d, 0x77, 0xd5, 0xef, 0x01, 0x00, 0xd4, 0x3a, 0x79, 0x07, 0xa0, 0x04,
+		0x00, 0x00,
+	}),
+	"/shell.js": embedded.NewFile("shell.js", time.Now(), 1068, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x9c, 0x51, 0x4d, 0x8b, 0xdb, 0x30,
+		0x14, 0xbc, 0xeb, 0x57, 0x4c, 0xa1, 0x60, 0xeb, 0xe2, 0x3f, 0x60, 0xdc, 0xdb, 0x16, 0xf6, 0xb6,
+		0x87, 0xbd, 0x95, 0xc2, 0x6a, 0xe3, 0xb7, 0xb1, 0x41, 0xd6, 0x4b, 0x9f, 0xe4, 0x24, 0x10, 0xf4,
+		0xdf, 0x8b, 0x1c, 0xc7, 0x89, 0xe3, 0x24, 0xa5, 0x39, 0x19, 0xe6, 0xcd, 0x68, 0x3e, 0xbc, 0x62,
+		0xe7, 0x03, 0x0e, 0xf0, 0x0d, 0x59, 0x8b, 0x88, 0x0a, 0x42, 0x7f, 0xfa, 0x56, 0x28, 0xcf, 0xc8,
+		0xd2, 0x2a, 0x08, 0xbb, 0x4c, 0x2b, 0xd5, 0x71, 0xdd, 0x5b, 0x2a, 0x68, 0xbf, 0x61, 0x09, 0x1e,
+		0x15, 0xf2, 0x96, 0x9d, 0x46, 0xf5, 0x03, 0x07, 0x05, 0xb4, 0xec, 0x8a, 0x15, 0x77, 0x9d, 0x71,
+		0xb5, 0xff, 0x95, 0x0d, 0x4f, 0x15, 0xbc, 0x21, 0xf7, 0xb2, 0x0f, 0x24, 0xce, 0xd8, 0xec, 0x77,
+		0x52, 0x18, 0x59, 0xfb, 0x41, 0xf2, 0x26, 0xdc, 0xb5, 0x9e, 0x0a, 0x21, 0xcf, 0x76, 0x4b, 0xf9,
+		0x52, 0x30, 0x70, 0x8b, 0x60, 0x64, 0x4d, 0x41, 0x6b, 0x05, 0x00, 0x45, 0x68, 0xc8, 0xe5, 0x79,
+		0x62, 0x51, 0x3d, 0x39, 0xa7, 0x4b, 0xfb, 0x85, 0x11, 0x46, 0x55, 0x55, 0xf8, 0x32, 0xd6, 0x93,
+		0x9e, 0xae, 0x40, 0x68, 0x84, 0x77, 0x70, 0xb4, 0xc3, 0x8b, 0x08, 0x4b, 0xfe, 0xd1, 0x3b, 0xf3,
+		0x69, 0x09, 0x81, 0x91, 0x64, 0xf8, 0x7e, 0xb8, 0x70, 0x8b, 0x1f, 0xba, 0x1c, 0x95, 0x71, 0xf8,
+		0x46, 0x5d, 0xaa, 0x07, 0x15, 0xdf, 0x4c, 0x68, 0xae, 0xea, 0x1d, 0x9d, 0x53, 0xaa, 0x39, 0xed,
+		0x9c, 0x49, 0x28, 0xf4, 0xe2, 0x30, 0x3f, 0xcf, 0x3a, 0x8f, 0x6d, 0x49, 0x64, 0x56, 0xf5, 0xf8,
+		0xec, 0x80, 0x9e, 0xa1, 0x65, 0xc3, 0x44, 0x38, 0xb5, 0x38, 0xf5, 0x00, 0xe2, 0x88, 0xc5, 0x29,
+		0xdf, 0xb7, 0x73, 0x82, 0xd7, 0x40, 0xdd, 0x7c, 0xf5, 0xc9, 0xf4, 0xb9, 0xfd, 0xa2, 0xba, 0x68,
+		0xea, 0x7a, 0x6b, 0x13, 0x1c, 0xef, 0x6e, 0xe9, 0x1b, 0xde, 0xa5, 0x0c, 0xaf, 0xee, 0x27, 0xdb,
+		0x9a, 0xe4, 0xe6, 0xa6, 0xb7, 0xa9, 0xb3, 0xd8, 0x8f, 0x5d, 0x3a, 0xde, 0x52, 0x92, 0xbe, 0xf3,
+		0xbb, 0x18, 0xff, 0xaf, 0x1f, 0x17, 0x12, 0x27, 0xd1, 0xef, 0xfc, 0xb9, 0xe9, 0xbe, 0x48, 0x70,
+		0x63, 0xe4, 0x2b, 0xeb, 0xff, 0xdf, 0x3a, 0x3d, 0x70, 0xb5, 0x75, 0x82, 0x43, 0x43, 0x18, 0x82,
+		0x3c, 0x39, 0xfc, 0x27, 0xd1, 0xe6, 0xb8, 0xc3, 0x62, 0xe8, 0x74, 0xca, 0x75, 0xa9, 0x80, 0x58,
+		0xaa, 0x58, 0xaa, 0xbf, 0x03, 0x00, 0x9a, 0xf8, 0x48, 0xdb, 0x2c, 0x04, 0x00, 0x00,
+	}),
+	"/shortcut.js": embedded.NewFile("shortcut.js", time.Now(), 2918, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xad, 0x56, 0x4d, 0x8f, 0xdb, 0x36,
+		0x10, 0xbd, 0xfb, 0x57, 0xcc, 0x02, 0x41, 0x24, 0x03, 0x5a, 0xe5, 0xee, 0x85, 0x5b, 0xb4, 0xbb,
+		0x8b, 0x60, 0xd1, 0x16, 0x05, 0xd2, 0x43, 0x0f, 0x45, 0x81, 0xd0, 0xe2, 0xc8, 0x22, 0x4c, 0x91,
+		0x2a, 0x49, 0xc5, 0x35, 0x36, 0xfe, 0xef, 0x1d, 0x91, 0xfa, 0xa0, 0x64, 0x37, 0xde, 0x02, 0xbd,
+		0xd8, 0x32, 0xf9, 0x38, 0xf3, 0xe6, 0x71, 0xde, 0xc8, 0x85, 0x56, 0xd6, 0xc1, 0x2b, 0xb0, 0xa6,
+		0xc9, 0xe0, 0x47, 0xa3, 0x8f, 0x16, 0xcd, 0xef, 0x42, 0x71, 0x7d, 0xcc, 0x60, 0x2f, 0xf5, 0x8e,
+		0xc9, 0xdf, 0x2a, 0x6d, 0x5c, 0xd1, 0x3a, 0x38, 0xc3, 0x16, 0x0c, 0xfe, 0xd5, 0x0a, 0x83, 0x69,
+		0x82, 0x12, 0x0b, 0x67, 0xb4, 0x4a, 0xd6, 0xab, 0xd5, 0x87, 0x0f, 0xf0, 0x09, 0x1b, 0x42, 0x59,
+		0x38, 0x56, 0xe8, 0x2a, 0x34, 0x90, 0xec, 0xb0, 0xd4, 0x06, 0xef, 0x85, 0x6a, 0x5a, 0x77, 0x8f,
+		0x5f, 0x50, 0xb9, 0x04, 0xfc, 0x0f, 0xa8, 0x99, 0x2b, 0x2a, 0xb4, 0xc0, 0xe0, 0xe8, 0xf3, 0x00,
+		0x2b, 0x0a, 0x8a, 0x66, 0x98, 0xd3, 0x26, 0x03, 0x66, 0xbb, 0x70, 0x0d, 0x33, 0x16, 0x39, 0xec,
+		0x4e, 0xf0, 0x51, 0xe7, 0xf0, 0x13, 0x9e, 0x08, 0x6e, 0x10, 0x0a, 0x5d, 0xd3, 0x4e, 0xd8, 0x68,
+		0xaa, 0x93, 0x15, 0x05, 0x93, 0xb4, 0xc8, 0xb1, 0xcb, 0x4b, 0xfb, 0x5a, 0x21, 0x08, 0x0b, 0x07,
+		0xa5, 0x8f, 0x2a, 0x03, 0x2b, 0x54, 0x81, 0x5d, 0x34, 0x62, 0x04, 0x45, 0xc5, 0x0c, 0x2b, 0x1c,
+		0x51, 0x6b, 0x8c, 0xe6, 0x6d, 0x41, 0x41, 0x38, 0x36, 0xa8, 0xb8, 0xa5, 0x53, 0x1e, 0x71, 0xc0,
+		0xd3, 0x4e, 0x33, 0xc3, 0x41, 0xb2, 0x93, 0x26, 0x9e, 0x4c, 0x71, 0xbf, 0x5e, 0x6b, 0x2e, 0x4a,
+		0x81, 0xc6, 0x13, 0xab, 0x50, 0xf2, 0x7c, 0x55, 0x78, 0xd1, 0x86, 0x42, 0xb6, 0x90, 0xce, 0x4a,
+		0xf0, 0x65, 0xae, 0x61, 0xfb, 0x5d, 0x78, 0xca, 0x6b, 0x74, 0x0c, 0xb6, 0xdb, 0x6d, 0x5c, 0xa8,
+		0x5f, 0x5c, 0x01, 0xbc, 0x7f, 0xdf, 0x83, 0x28, 0x24, 0xc9, 0x29, 0x2f, 0x70, 0xfd, 0x7a, 0x0c,
+		0x65, 0xd2, 0x5d, 0xc0, 0x68, 0x2d, 0x40, 0xe2, 0x45, 0xaa, 0xc8, 0xe6, 0x56, 0xd7, 0x98, 0xa6,
+		0x87, 0x88, 0x8f, 0xad, 0x44, 0x19, 0x22, 0x1c, 0xc2, 0x33, 0x9d, 0xf4, 0x67, 0xd3, 0x43, 0xee,
+		0xc5, 0xfc, 0x7e, 0xe4, 0x44, 0x3f, 0x02, 0xce, 0x3f, 0x6e, 0xfa, 0x75, 0x8a, 0x9b, 0x3b, 0xfd,
+		0xb3, 0x3e, 0xa2, 0x79, 0x64, 0x16, 0xd3, 0x75, 0x0f, 0xa2, 0xf5, 0xf5, 0xfa, 0x61, 0xb5, 0x22,
+		0xc5, 0x5a, 0x89, 0x39, 0xfe, 0x1d, 0x7a, 0x82, 0xf4, 0x11, 0x5a, 0x79, 0x02, 0xaf, 0x94, 0x8a,
+		0x54, 0x0c, 0x0d, 0x06, 0xb6, 0xef, 0x2c, 0x9b, 0x75, 0xe2, 0x87, 0x6b, 0xed, 0x7b, 0xe2, 0xe5,
+		0x29, 0xeb, 0xc4, 0x57, 0xb3, 0x7a, 0xe8, 0x70, 0x50, 0x3e, 0x80, 0x86, 0xc6, 0xec, 0x32, 0x28,
+		0x3c, 0xc2, 0x2f, 0xac, 0x49, 0xbb, 0xf4, 0x03, 0x4a, 0x6a, 0x7d, 0x68, 0x1b, 0x9f, 0x9e, 0x8f,
+		0xd9, 0xa3, 0x10, 0xb4, 0x33, 0x6b, 0xf8, 0xbc, 0x34, 0xba, 0x7e, 0xe1, 0x1d, 0xfa, 0xc1, 0x23,
+		0x45, 0x09, 0xa9, 0xc7, 0x51, 0x75, 0xaa, 0x95, 0x72, 0xdd, 0x47, 0x00, 0xa2, 0x46, 0x07, 0x7d,
+		0xd2, 0x67, 0x63, 0xb4, 0x49, 0x3f, 0xb7, 0xca, 0x37, 0x5d, 0xcf, 0x6c, 0x03, 0xef, 0x5e, 0x05,
+		0x3f, 0x7f, 0xee, 0xe3, 0x9c, 0xfd, 0xa7, 0x41, 0xd7, 0x1a, 0x8f, 0xe8, 0x56, 0xcf, 0x9e, 0x28,
+		0xe9, 0x42, 0xd2, 0xd6, 0x35, 0xf5, 0x9a, 0xfd, 0x23, 0x19, 0xf4, 0xc8, 0x0d, 0xee, 0x85, 0xa5,
+		0x5e, 0xfd, 0xe8, 0x0d, 0x98, 0xfc, 0xe9, 0x5b, 0xcc, 0xec, 0x6d, 0x54, 0x45, 0xc7, 0x6d, 0xee,
+		0xcf, 0x5c, 0xd8, 0x4f, 0xfd, 0x39, 0xe4, 0x1e, 0x9e, 0x47, 0xe2, 0xad, 0xbf, 0x41, 0xfe, 0xdd,
+		0xeb, 0x12, 0x7d, 0xee, 0x6c, 0xc4, 0xa4, 0x41, 0xc6, 0x4f, 0x60, 0xc6, 0xa8, 0xf3, 0x82, 0x82,
+		0x90, 0xd3, 0x2e, 0xb1, 0x5c, 0x30, 0x1a, 0xf6, 0x2e, 0xd8, 0x64, 0x90, 0x46, 0xb5, 0x04, 0x1d,
+		0xb0, 0x16, 0x2e, 0x9d, 0x34, 0x08, 0xa1, 0x92, 0xac, 0x9b, 0x4d, 0xd3, 0xc1, 0x0d, 0x2c, 0x43,
+		0xc1, 0x79, 0x20, 0xd5, 0x7f, 0x53, 0x7f, 0xfd, 0x2a, 0x39, 0x19, 0xfd, 0x0b, 0x79, 0x96, 0x02,
+		0x93, 0xc1, 0x4b, 0x78, 0xee, 0xc7, 0xd5, 0x70, 0x0b, 0xad, 0xe2, 0x58, 0x0a, 0x45, 0xa4, 0x29,
+		0x48, 0x37, 0xb0, 0x5c, 0xc5, 0xa8, 0xd9, 0xc0, 0xb6, 0x14, 0xd9, 0x5a, 0x28, 0x25, 0xdb, 0xe7,
+		0xa3, 0xd0, 0x71, 0x8d, 0xd4, 0x0b, 0x25, 0x93, 0x16, 0xe1, 0xeb, 0x57, 0xb8, 0xfb, 0xbf, 0x6e,
+		0xa0, 0x55, 0x6c, 0x27, 0x11, 0x9c, 0x1e, 0xe5, 0x84, 0x2b, 0x97, 0xf2, 0x00, 0xa2, 0x9b, 0x3a,
+		0x27, 0xd8, 0xd1, 0x98, 0xa3, 0x1a, 0x88, 0x04, 0x39, 0x86, 0x29, 0xed, 0x2b, 0xa0, 0x09, 0x2e,
+		0x69, 0x20, 0x3a, 0x2a, 0x39, 0xbe, 0xa7, 0x6f, 0x36, 0x5a, 0xab, 0xde, 0xd0, 0x6a, 0x8b, 0x22,
+		0xa7, 0x33, 0x97, 0x25, 0xbe, 0xb9, 0xb3, 0x83, 0xe3, 0xae, 0xa6, 0x8b, 0xfd, 0x19, 0x2c, 0x1c,
+		0xf2, 0x8c, 0xb6, 0x8c, 0x67, 0xc0, 0xcb, 0x13, 0xa1, 0xfa, 0xed, 0xb0, 0x2b, 0xd1, 0x4d, 0x83,
+		0x85, 0x36, 0x17, 0xb3, 0x22, 0xdf, 0xa3, 0x4b, 0x87, 0xb3, 0x91, 0xcf, 0xa3, 0x23, 0x74, 0xc3,
+		0x63, 0x7b, 0x4c, 0x77, 0x66, 0xaf, 0x4f, 0x9b, 0xb0, 0xb9, 0x4c, 0x62, 0xa3, 0x24, 0xd9, 0x74,
+		0x34, 0xc6, 0xe7, 0x47, 0xdc, 0x3d, 0xd2, 0x70, 0xa7, 0x37, 0xa3, 0xcd, 0xb5, 0x4a, 0xaf, 0xbd,
+		0x31, 0xc9, 0x26, 0xfe, 0x21, 0x7e, 0xa7, 0x0c, 0x7c, 0x02, 0xed, 0x30, 0x90, 0xdd, 0xa9, 0x41,
+		0xb8, 0x23, 0xde, 0x09, 0xcd, 0xd1, 0x27, 0x9a, 0x43, 0xc9, 0x3a, 0xc2, 0x0d, 0xa3, 0xe7, 0x61,
+		0x5c, 0x39, 0x8f, 0x4f, 0x23, 0xb5, 0x9c, 0x92, 0x3f, 0xb3, 0xa2, 0x4a, 0x53, 0xff, 0x5a, 0xcb,
+		0x62, 0xd3, 0x2d, 0xf2, 0x86, 0xcc, 0xfd, 0xdb, 0x6f, 0x80, 0x07, 0x82, 0xf3, 0xb4, 0x00, 0x9e,
+		0x7d, 0xde, 0x18, 0xff, 0xfd, 0x84, 0x25, 0x6b, 0xa5, 0x9b, 0x54, 0xfb, 0x57, 0xef, 0x07, 0xe9,
+		0x96, 0xde, 0xcf, 0xa6, 0x3b, 0x3f, 0xcf, 0x62, 0x4c, 0xe5, 0x4c, 0xeb, 0xe7, 0x99, 0xd6, 0x9d,
+		0xbe, 0x85, 0xd4, 0xf4, 0x9f, 0x22, 0x59, 0x8e, 0x9e, 0xcb, 0xcb, 0xe3, 0x94, 0xd0, 0xe1, 0xb2,
+		0x49, 0x06, 0xaa, 0x96, 0xfe, 0x33, 0xa4, 0xaf, 0x50, 0xd4, 0x7c, 0x03, 0x4b, 0xc6, 0x8f, 0x43,
+		0x8a, 0xae, 0x23, 0x37, 0xc4, 0x5e, 0x10, 0x68, 0x22, 0x7d, 0x85, 0x5e, 0xa0, 0x6e, 0x67, 0x8d,
+		0x73, 0x39, 0x2c, 0xfd, 0x8a, 0x17, 0xfa, 0xb6, 0xc3, 0x26, 0x7b, 0xde, 0xf4, 0xd8, 0x2d, 0x9b,
+		0xcc, 0x6d, 0x37, 0x77, 0xc9, 0xdd, 0x0d, 0x97, 0x0c, 0x2a, 0x5e, 0x1d, 0x11, 0xff, 0x69, 0x32,
+		0xfd, 0x20, 0xfb, 0xb1, 0xf4, 0x96, 0x91, 0x44, 0xe0, 0xa1, 0xbf, 0x96, 0x15, 0x8d, 0x0d, 0x3e,
+		0xd9, 0xb1, 0x0b, 0x38, 0x31, 0x2e, 0x24, 0x32, 0x93, 0xae, 0x23, 0x89, 0x69, 0xa4, 0xfa, 0xd6,
+		0x39, 0x0a, 0x29, 0xef, 0xe9, 0x8f, 0xaf, 0x5b, 0x74, 0xcf, 0x6d, 0x1a, 0xdd, 0x3d, 0x53, 0xac,
+		0x7f, 0x00, 0xbb, 0x8a, 0x43, 0x45, 0x66, 0x0b, 0x00, 0x00,
+	}),
+	"/tray.js": embedded.NewFile("tray.js", time.Now(), 1395, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x94, 0x94, 0xc1, 0x8e, 0xd3, 0x30,
+		0x10, 0x86, 0xef, 0x79, 0x8a, 0x39, 0x20, 0xd9, 0x96, 0x42, 0xb8, 0x20, 0x0e, 0xad, 0x02, 0x12,
+		0x2c, 0x48, 0x3d, 0xec, 0xad, 0x37, 0x84, 0xb4, 0x69, 0x3c, 0xed, 0x5a, 0x75, 0x3c, 0xc5, 0xb1,
+		0xe9, 0x56, 0xdd, 0xbc, 0x3b, 0x1a, 0x27, 0xdd, 0x06, 0x51, 0x42, 0xf6, 0x52, 0x4b, 0x33, 0xff,
+		0x7c, 0xbf, 0x67, 0x3c, 0x4d, 0x4d, 0xae, 0x0d, 0x70, 0x86, 0xb5, 0xaf, 0x4e, 0x39, 0xb8, 0x2a,
+		0x98, 0x5f, 0xb8, 0x6a, 0xaa, 0x1d, 0x42, 0x07, 0x25, 0x78, 0xfc, 0x19, 0x8d, 0x47, 0x29, 0xd0,
+		0x62, 0x1d, 0x3c, 0x39, 0xa1, 0xb2, 0xbe, 0xa2, 0x41, 0x17, 0xc7, 0x82, 0xe2, 0x1d, 0x47, 0x84,
+		0xca, 0xb2, 0x86, 0x74, 0xb4, 0x58, 0xe0, 0xd3, 0x81, 0x7c, 0x68, 0xa1, 0x04, 0x69, 0xc8, 0x29,
+		0x28, 0x3f, 0xc2, 0x39, 0x03, 0xe8, 0xab, 0x83, 0xaf, 0x4e, 0x9c, 0x72, 0x78, 0x84, 0xfb, 0xea,
+		0x20, 0xd5, 0x32, 0x7b, 0xc9, 0x59, 0xa2, 0x7d, 0x3c, 0xa4, 0x3a, 0xfd, 0x52, 0x36, 0x2e, 0x84,
+		0x32, 0x1d, 0x6d, 0xb1, 0xc3, 0x20, 0x8d, 0x56, 0xcb, 0x94, 0x37, 0x5b, 0x90, 0x1c, 0x86, 0xb2,
+		0x2c, 0x21, 0x3a, 0x8d, 0x5b, 0xe3, 0x50, 0xab, 0xa1, 0x1a, 0x20, 0x3c, 0x7a, 0x3a, 0x26, 0xc3,
+		0xaf, 0xde, 0x93, 0x97, 0x0f, 0xd1, 0xed, 0x1d, 0x1d, 0x5d, 0x62, 0x2d, 0xe0, 0xcd, 0xd9, 0xe8,
+		0xee, 0x61, 0x60, 0x75, 0xe9, 0xd7, 0x63, 0x88, 0xbe, 0xcf, 0x73, 0xb8, 0x1b, 0xdd, 0xd1, 0xa4,
+		0x09, 0xf1, 0x15, 0xeb, 0xa1, 0xb7, 0xd1, 0xe4, 0x8a, 0xda, 0x63, 0x15, 0xf0, 0x9b, 0xa7, 0xe6,
+		0x73, 0xdc, 0x6e, 0xd1, 0xcb, 0xfe, 0x28, 0xb6, 0x9e, 0x9a, 0x54, 0x91, 0x83, 0xd8, 0x54, 0x2d,
+		0x7e, 0x78, 0x2f, 0x54, 0xdf, 0xb9, 0x21, 0x57, 0xd4, 0xd4, 0x34, 0x95, 0xd3, 0xed, 0x77, 0xc1,
+		0x8e, 0x03, 0x44, 0xfc, 0xe0, 0x41, 0x54, 0x7e, 0xd7, 0xfe, 0x6b, 0x14, 0xdc, 0x11, 0x3f, 0x9e,
+		0x4c, 0x77, 0x4a, 0xd2, 0x82, 0x3d, 0xd4, 0xd0, 0x4b, 0x82, 0x91, 0x93, 0xa2, 0xb6, 0xa6, 0xde,
+		0x8b, 0x1c, 0x64, 0x22, 0xb1, 0x23, 0x36, 0x26, 0xc8, 0xc1, 0x8d, 0x93, 0xa8, 0x45, 0x0e, 0xe7,
+		0x04, 0x5e, 0xdd, 0x2d, 0xa0, 0x47, 0x69, 0xe8, 0xfe, 0x42, 0x69, 0x8a, 0x1b, 0x8b, 0x6f, 0x27,
+		0x89, 0x63, 0xcd, 0x6c, 0xb0, 0x37, 0xbb, 0xc7, 0x30, 0xcd, 0x1d, 0x49, 0xe6, 0x60, 0xdb, 0xa2,
+		0xc5, 0x30, 0x4c, 0x45, 0xe7, 0x49, 0xab, 0xae, 0xaf, 0x79, 0x63, 0xee, 0x2d, 0x86, 0x55, 0x4d,
+		0xee, 0xe6, 0xe0, 0xfb, 0xd5, 0xbc, 0xd0, 0x14, 0xa3, 0xd3, 0x8b, 0xdf, 0x9e, 0xfd, 0x94, 0xc5,
+		0x9a, 0xc8, 0xae, 0xcd, 0x61, 0xae, 0xcb, 0x20, 0xef, 0x1d, 0x02, 0x3e, 0x05, 0x78, 0x7e, 0x06,
+		0x21, 0xfe, 0x6f, 0x63, 0x82, 0xc5, 0xd9, 0x26, 0x2c, 0x7e, 0xb5, 0xc5, 0x3d, 0xff, 0xed, 0x67,
+		0x3a, 0x7c, 0x21, 0xc7, 0x64, 0x2e, 0xe9, 0xe3, 0xfc, 0xcd, 0x80, 0x4f, 0xc0, 0x47, 0xb1, 0x89,
+		0xc6, 0xea, 0x6b, 0x38, 0x07, 0xc9, 0xc7, 0x2a, 0x60, 0xb3, 0xba, 0x1b, 0x61, 0x61, 0xb4, 0x10,
+		0x2c, 0x98, 0x5a, 0xdd, 0x1c, 0xae, 0x08, 0xe8, 0x86, 0xad, 0xe8, 0x14, 0x2c, 0xc0, 0x45, 0x6b,
+		0xa7, 0x5b, 0xd3, 0xd8, 0x06, 0x4f, 0xa7, 0x59, 0xad, 0x0d, 0x5a, 0xf9, 0xc7, 0xde, 0x69, 0xb4,
+		0x18, 0x2e, 0x4b, 0xa1, 0x2f, 0x66, 0xdd, 0x32, 0xfb, 0x3d, 0x00, 0x3c, 0x74, 0x34, 0x04, 0x73,
+		0x05, 0x00, 0x00,
+	}),
+	"/window.js": embedded.NewFile("window.js", time.Now(), 1819, true, []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x8d, 0x54, 0xc9, 0x6e, 0xdb, 0x30,
+		0x10, 0xbd, 0xfb, 0x2b, 0xe6, 0x10, 0x40, 0x12, 0x60, 0x2b, 0x39, 0xdb, 0x70, 0x83, 0xb6, 0xe8,
+		0xa1, 0x39, 0xb4, 0x01, 0x5c, 0x20, 0x87, 0xa2, 0x80, 0x29, 0x69, 0x6c, 0xb3, 0xa6, 0x48, 0x97,
+		0xa4, 0xa2, 0x18, 0x86, 0xff, 0xbd, 0xc3, 0x45, 0x8b, 0xe3, 0x74, 0xb9, 0x78, 0x21, 0xdf, 0xbc,
+		0x99, 0xe1, 0xbc, 0x37, 0xa5, 0x92, 0xc6, 0xc2, 0x09, 0x3e, 0x68, 0xd5, 0x1a, 0xd4, 0x4f, 0x5c,
+		0x56, 0xaa, 0x85, 0x33, 0x2c, 0x41, 0xe3, 0xaf, 0x86, 0x6b, 0x4c, 0x13, 0x14, 0x58, 0x5a, 0xad,
+		0x64, 0x92, 0x4d, 0x26, 0xb5, 0xaa, 0x1a, 0x81, 0x39, 0xbe, 0x1c, 0x94, 0xb6, 0x86, 0x50, 0x29,
+		0x57, 0x32, 0x83, 0xe5, 0x3b, 0x38, 0x4d, 0x00, 0x4a, 0x4f, 0x26, 0x94, 0xda, 0x37, 0x07, 0x7f,
+		0x57, 0xf5, 0x57, 0xdd, 0x65, 0xcb, 0x25, 0xdd, 0x5c, 0x64, 0xcb, 0x37, 0x5a, 0xd5, 0x9f, 0x2b,
+		0x87, 0x5e, 0x78, 0x24, 0xdf, 0x40, 0xea, 0x71, 0xcb, 0x25, 0xc8, 0x46, 0x88, 0x2c, 0x32, 0x00,
+		0xd8, 0x1d, 0x05, 0x82, 0xc4, 0x16, 0x3e, 0x69, 0xad, 0x74, 0xba, 0x6e, 0xe4, 0x5e, 0xaa, 0x56,
+		0x3a, 0x5a, 0x62, 0x9a, 0xc3, 0xcd, 0x89, 0x57, 0xe7, 0x75, 0xe4, 0x39, 0xfb, 0x4f, 0x8d, 0xb6,
+		0xd1, 0x1e, 0xe1, 0x4e, 0xcf, 0x8b, 0x09, 0x7d, 0xde, 0xde, 0xc2, 0x93, 0x66, 0x07, 0x43, 0x45,
+		0x55, 0x08, 0x56, 0x41, 0x81, 0xa0, 0x1b, 0x09, 0x94, 0x94, 0x51, 0x80, 0xac, 0x50, 0xa3, 0x06,
+		0xa3, 0x28, 0x21, 0xb3, 0xb0, 0x61, 0x5c, 0x34, 0x1a, 0x1d, 0xba, 0x46, 0x28, 0x58, 0xb9, 0x27,
+		0x36, 0xbb, 0xa3, 0x4b, 0xe4, 0x3a, 0xb0, 0xd5, 0x68, 0x0c, 0xdb, 0x22, 0x30, 0x59, 0x81, 0xb1,
+		0x0e, 0xc1, 0x25, 0x7d, 0xd9, 0x1c, 0xbe, 0xed, 0xb8, 0x21, 0x4a, 0xc1, 0x29, 0x5e, 0x49, 0xc0,
+		0x67, 0x26, 0xa6, 0xd0, 0xee, 0x78, 0xb9, 0xa3, 0x54, 0x07, 0x8a, 0x49, 0x0c, 0x7c, 0x54, 0xd2,
+		0xa2, 0xb4, 0x81, 0x6a, 0x85, 0x65, 0xa3, 0xb9, 0x3d, 0xc2, 0xa3, 0x12, 0xbc, 0x3c, 0x42, 0xcd,
+		0x8e, 0xb0, 0x51, 0xba, 0xe0, 0xd5, 0xc2, 0x65, 0x0c, 0x25, 0xef, 0x98, 0x01, 0xa9, 0xac, 0x2f,
+		0xba, 0xdd, 0xa1, 0x0c, 0x85, 0xfa, 0x4c, 0x6e, 0x32, 0x58, 0xe5, 0xfd, 0x38, 0x5a, 0x6a, 0xd4,
+		0x0d, 0xc3, 0xc5, 0xf9, 0x71, 0xac, 0xd3, 0x74, 0x34, 0x16, 0xab, 0x8f, 0xfd, 0xf3, 0xba, 0xe2,
+		0xd2, 0xe4, 0x2e, 0xe9, 0xde, 0x0f, 0x4a, 0x66, 0xa9, 0xce, 0x14, 0xb5, 0x1e, 0x66, 0x10, 0xdf,
+		0xf3, 0x04, 0x85, 0x50, 0xe5, 0x1e, 0xab, 0x39, 0x51, 0x34, 0xe8, 0x1e, 0xf6, 0xea, 0xcd, 0x1f,
+		0x69, 0xb0, 0xdc, 0x60, 0x4e, 0x6f, 0xa7, 0xc4, 0x33, 0xa6, 0x59, 0xa4, 0xc8, 0xa9, 0x0f, 0x19,
+		0xab, 0xf0, 0x39, 0x6f, 0x4e, 0x0f, 0xab, 0xaf, 0x5f, 0x72, 0x63, 0x35, 0x97, 0x5b, 0xbe, 0x39,
+		0x86, 0x62, 0xcf, 0xd9, 0xab, 0x00, 0x82, 0x36, 0xa1, 0x87, 0xf4, 0x04, 0xfe, 0x0f, 0x9c, 0xb3,
+		0x69, 0xac, 0xcf, 0x9f, 0x46, 0x3c, 0xb5, 0xe2, 0xf4, 0x31, 0x87, 0xe1, 0x00, 0xba, 0x19, 0xcd,
+		0xdd, 0x1d, 0x8d, 0x87, 0xc6, 0x24, 0x4b, 0x54, 0x9b, 0x20, 0x25, 0xb8, 0x77, 0xc7, 0x79, 0x37,
+		0xc7, 0x39, 0xac, 0x7c, 0x2d, 0x9e, 0x7a, 0x3a, 0x22, 0xf1, 0xc3, 0xfd, 0x2b, 0x45, 0x18, 0xff,
+		0x1c, 0x92, 0x64, 0x88, 0x3b, 0x77, 0x3f, 0xa9, 0x25, 0xaf, 0xc2, 0x2c, 0xcd, 0xd6, 0x5e, 0x89,
+		0x64, 0x9f, 0x9c, 0x64, 0x55, 0x93, 0x70, 0xcc, 0xf7, 0x24, 0xc8, 0x38, 0x17, 0xdc, 0xd8, 0xe4,
+		0x87, 0x1b, 0x9a, 0x6f, 0xeb, 0xd2, 0x2d, 0x5b, 0xb4, 0xef, 0x85, 0x08, 0x7f, 0x4c, 0x9a, 0xe5,
+		0x35, 0x3b, 0xa4, 0xce, 0x2e, 0x1e, 0x4a, 0xdf, 0xb9, 0xf7, 0xd1, 0x9f, 0xa8, 0x6b, 0xc6, 0xe5,
+		0x88, 0xfa, 0xda, 0x9a, 0x2e, 0xca, 0x81, 0x42, 0x82, 0x34, 0x4a, 0x61, 0x30, 0x11, 0x75, 0x19,
+		0x92, 0x50, 0x8b, 0x77, 0x83, 0xa3, 0xde, 0x4c, 0x86, 0x2f, 0xa4, 0x65, 0x8b, 0x0f, 0xec, 0x99,
+		0xad, 0x4a, 0xcd, 0x0f, 0xb1, 0x29, 0xa6, 0xb7, 0xe6, 0x2a, 0xfb, 0x09, 0x5a, 0x2c, 0xa2, 0x11,
+		0x8c, 0x5f, 0x40, 0x61, 0x8f, 0x78, 0x74, 0xde, 0xef, 0x86, 0xae, 0x90, 0x01, 0x7b, 0x9d, 0x25,
+		0x75, 0x92, 0x0f, 0x71, 0x5e, 0x47, 0x53, 0xaf, 0xd0, 0x2c, 0x8a, 0x48, 0x35, 0xd6, 0xf9, 0x78,
+		0x54, 0x40, 0xd8, 0x38, 0xf1, 0x3c, 0x8f, 0xb2, 0xce, 0x46, 0xda, 0x21, 0x57, 0x5e, 0x25, 0x01,
+		0x6e, 0x0d, 0x8a, 0x8d, 0xf3, 0x9c, 0x33, 0xa2, 0x69, 0x8a, 0x9f, 0xb4, 0x26, 0xdd, 0x2e, 0x71,
+		0x26, 0x8d, 0xc6, 0x3e, 0x78, 0x0b, 0x4f, 0xa1, 0x68, 0xec, 0x98, 0xac, 0x5f, 0x28, 0x4c, 0xa3,
+		0x83, 0xcb, 0xde, 0xb6, 0x7e, 0xb1, 0x50, 0x25, 0x61, 0xb7, 0x40, 0x85, 0x96, 0xa0, 0x79, 0x1f,
+		0xfb, 0x5f, 0xdd, 0xf7, 0x8d, 0xc7, 0xbe, 0x17, 0x9d, 0xf6, 0xde, 0x68, 0xd6, 0xdb, 0x64, 0xdc,
+		0x6a, 0x18, 0x86, 0x93, 0xf7, 0x72, 0xb4, 0x67, 0x2f, 0xe0, 0x9d, 0x49, 0x7a, 0x62, 0x18, 0xe9,
+		0x7e, 0x09, 0x97, 0x58, 0x7f, 0x3a, 0x00, 0xc3, 0x02, 0xa7, 0xab, 0xd7, 0x45, 0xc5, 0xce, 0xba,
+		0x60, 0x6f, 0xee, 0xb8, 0x51, 0xb2, 0x8b, 0xbd, 0x8d, 0x46, 0xd0, 0x6a, 0x9d, 0x55, 0xdc, 0xb0,
+		0x42, 0xe0, 0x4c, 0xe2, 0x8b, 0x9d, 0xd1, 0x09, 0xd2, 0x14, 0x66, 0x6e, 0x97, 0x5c, 0x69, 0xd1,
+		0x89, 0xf9, 0x9f, 0x4a, 0xf4, 0x5b, 0x68, 0x50, 0xcc, 0x62, 0x42, 0xf9, 0x7e, 0x03, 0x51, 0x1f,
+		0x67, 0x35, 0x1b, 0x07, 0x00, 0x00,
 	}),
 })
@@ -0,0 +1,25 @@
+package ion
+
+// MenuItemType identifies the kind of menu item.
+type MenuItemType string
+
+// Possible values for MenuItemType.
+const (
+	NormalMenuItem    MenuItemType = "normal"
+	SeparatorMenuItem MenuItemType = "separator"
+	CheckboxMenuItem  MenuItemType = "checkbox"
+	RadioMenuItem     MenuItemType = "radio"
+	SubmenuMenuItem   MenuItemType = "submenu"
+)
+
+// MenuItem describes an item within a menu. When the item is selected by the
+// user, an event.MenuClicked event is sent with the item's ID.
+type MenuItem struct {
+	ID          string       `json:"id,omitempty"`
+	Type        MenuItemType `json:"type,omitempty"`
+	Label       string       `json:"label,omitempty"`
+	Accelerator string       `json:"accelerator,omitempty"`
+	Disabled    bool         `json:"disabled,omitempty"`
+	Checked     bool         `json:"checked,omitempty"`
+	Submenu     []*MenuItem  `json:"submenu,omitempty"`
+}
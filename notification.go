@@ -0,0 +1,66 @@
+package ion
+
+// Notification describes a desktop notification.
+type Notification struct {
+	Title string
+	Body  string
+	// Icon is the path to an image within the file system set via the
+	// IconFileSystem option. May be empty.
+	Icon   string
+	Silent bool
+	// Actions holds the labels of buttons to show with the notification.
+	// Only supported on macOS.
+	Actions []string
+	// HasReply adds an inline reply field to the notification. Only
+	// supported on macOS.
+	HasReply bool
+}
+
+// NotificationHandle refers to a notification that has been shown.
+type NotificationHandle struct {
+	ion *Ion
+	id  int64
+}
+
+type notificationArgs struct {
+	ID       int64    `json:"id"`
+	Title    string   `json:"title,omitempty"`
+	Body     string   `json:"body,omitempty"`
+	Icon     []byte   `json:"icon,omitempty"`
+	Silent   bool     `json:"silent,omitempty"`
+	Actions  []string `json:"actions,omitempty"`
+	HasReply bool     `json:"hasReply,omitempty"`
+}
+
+// Notify shows a desktop notification. Events originating from the
+// notification will carry the handle's ID in their NotificationID field.
+func (ion *Ion) Notify(notification Notification) (*NotificationHandle, error) {
+	args := &notificationArgs{
+		ID:       ion.nextID(),
+		Title:    notification.Title,
+		Body:     notification.Body,
+		Silent:   notification.Silent,
+		Actions:  notification.Actions,
+		HasReply: notification.HasReply,
+	}
+	if notification.Icon != "" {
+		var err error
+		if args.Icon, err = ion.loadIcon(notification.Icon); err != nil {
+			return nil, err
+		}
+	}
+	if err := ion.invoke("notification.show", args, nil); err != nil {
+		return nil, err
+	}
+	return &NotificationHandle{ion: ion, id: args.ID}, nil
+}
+
+// ID returns the ID of the notification.
+func (n *NotificationHandle) ID() int64 {
+	return n.id
+}
+
+// Close dismisses the notification.
+func (n *NotificationHandle) Close() error {
+	return n.ion.invoke("notification.close", &notificationArgs{ID: n.id}, nil)
+}
@@ -2,6 +2,7 @@ package ion
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/richardwilkes/ion/provisioner"
 	"github.com/richardwilkes/toolbox/log/logadapter"
@@ -24,9 +25,77 @@ func MacOSAppBundleID(id string) Option {
 // ElectronArchiveRetriever sets an ArchiveRetriever to use for Electron
 // before the default one.
 func ElectronArchiveRetriever(retriever provisioner.ArchiveRetriever) Option {
+	return func(ion *Ion) {
+		if retriever != nil {
+			ion.electronArchiveRetriever = provisioner.StreamingFromArchiveRetriever(retriever)
+		}
+	}
+}
+
+// ElectronStreamingArchiveRetriever sets a StreamingArchiveRetriever to use
+// for Electron before the default one. Unlike ElectronArchiveRetriever, the
+// archive need not be held in memory.
+func ElectronStreamingArchiveRetriever(retriever provisioner.StreamingArchiveRetriever) Option {
 	return func(ion *Ion) { ion.electronArchiveRetriever = retriever }
 }
 
+// ElectronDownloader sets the Downloader used to fetch Electron, allowing
+// retries and timeouts to be adjusted. Defaults to
+// provisioner.DefaultDownloader().
+func ElectronDownloader(downloader *provisioner.Downloader) Option {
+	return func(ion *Ion) { ion.electronDownloader = downloader }
+}
+
+// ElectronMirror sets the base URL Electron releases are downloaded from.
+// Defaults to the value of the ELECTRON_MIRROR environment variable, if set,
+// otherwise GitHub.
+func ElectronMirror(baseURL string) Option {
+	return func(ion *Ion) { ion.electronMirror = baseURL }
+}
+
+// ElectronVersion sets the version of Electron to provision. Defaults to
+// provisioner.ElectronVersion.
+func ElectronVersion(version string) Option {
+	return func(ion *Ion) { ion.electronRelease.Version = version }
+}
+
+// ElectronTarget sets the platform and architecture of Electron to provision,
+// using Electron's names for them, such as "linux" and "arm64". Either may be
+// empty to use the one matching the current machine. This is useful, for
+// example, to run x64 builds under emulation.
+func ElectronTarget(platform, arch string) Option {
+	return func(ion *Ion) {
+		ion.electronRelease.Platform = platform
+		ion.electronRelease.Arch = arch
+	}
+}
+
+// ElectronArchiveCache sets the cache downloaded Electron archives are shared
+// through. Defaults to provisioner.DefaultArchiveCache().
+func ElectronArchiveCache(cache *provisioner.ArchiveCache) Option {
+	return func(ion *Ion) { ion.electronArchiveCache = cache }
+}
+
+// DisableElectronArchiveCache prevents downloaded Electron archives from being
+// cached or taken from the cache.
+func DisableElectronArchiveCache() Option {
+	return func(ion *Ion) { ion.disableElectronCache = true }
+}
+
+// Verification sets how thoroughly previously provisioned files are checked
+// at startup. Defaults to provisioner.QuickVerification, which only examines
+// file metadata. provisioner.FullVerification also reads and hashes every
+// file, which is considerably slower.
+func Verification(mode provisioner.VerificationMode) Option {
+	return func(ion *Ion) { ion.verification = mode }
+}
+
+// Progress sets a ProgressReporter to receive updates while Electron is being
+// downloaded and extracted, which may take some time on first run.
+func Progress(progress provisioner.ProgressReporter) Option {
+	return func(ion *Ion) { ion.progress = progress }
+}
+
 // ProvisioningPath sets the provisioning path. The default varies by
 // platform.
 func ProvisioningPath(path string) Option {
@@ -40,3 +109,91 @@ func ProvisioningPath(path string) Option {
 func IconFileSystem(fs http.FileSystem) Option {
 	return func(ion *Ion) { ion.iconFileSystem = fs }
 }
+
+// ExternalURLSchemes sets the URL schemes that OpenExternal is permitted to
+// open. Defaults to "http", "https" and "mailto". Passing no schemes
+// prevents OpenExternal from opening anything.
+func ExternalURLSchemes(schemes ...string) Option {
+	return func(ion *Ion) {
+		// The copy is never nil, so that an empty list is distinguishable
+		// from the option not having been used.
+		ion.externalURLSchemes = append(make([]string, 0, len(schemes)), schemes...)
+	}
+}
+
+// ProtocolHandler registers a privileged URL scheme, such as "app", with
+// Electron and serves all requests made to it with 'handler'. The main
+// window will load its content from "<scheme>://app/", taking precedence over
+// any AppFileSystem.
+func ProtocolHandler(scheme string, handler http.Handler) Option {
+	return func(ion *Ion) {
+		ion.protocolScheme = scheme
+		ion.protocolHandler = handler
+	}
+}
+
+// AppFileSystem sets a file system containing the application's web assets.
+// They will be deployed into the provisioning path, being redeployed only
+// when 'version' changes or the deployed files have been altered. The main
+// window will load "/index.html" from these assets.
+func AppFileSystem(fs http.FileSystem, version string) Option {
+	return func(ion *Ion) {
+		ion.appFileSystem = fs
+		ion.appVersion = version
+	}
+}
+
+// Extensions sets a file system containing additional JavaScript modules to
+// run in Electron's main process. They are deployed into the provisioning
+// path in the same manner as AppFileSystem. Each ".js" file at the root of
+// the file system is loaded after ion's own modules and must export a
+// function, which will be called with an object providing access to the
+// connection with Go:
+//
+//	module.exports = (ion) => {
+//	  // Respond to Ion.Call(ctx, "myext.hello", args, &result)
+//	  ion.commands['myext.hello'] = (args) => `Hello, ${args.name}`;
+//	  // Dispatch an event; the data field arrives, as raw JSON, in Event.Data
+//	  ion.emit('myext.happened', { data: { count: 1 } });
+//	};
+func Extensions(fs http.FileSystem, version string) Option {
+	return func(ion *Ion) {
+		ion.extensionFileSystem = fs
+		ion.extensionVersion = version
+	}
+}
+
+// SingleInstance prevents more than one copy of the application from running
+// at a time. When a second copy is launched, it waits for the first to finish
+// starting, forwards its command line arguments and working directory to the
+// first as an event.AppSecondInstance event, then shuts down.
+func SingleInstance() Option {
+	return func(ion *Ion) { ion.singleInstance = true }
+}
+
+// URLSchemes registers the application as the handler for URLs with the given
+// schemes, such as "myapp". URLs delivered by the system, as well as any
+// found on the command line, are sent as event.AppOpenURL events. Combine
+// with SingleInstance to have URLs opened while the application is running
+// delivered to the existing instance. On macOS, the system launches the
+// provisioned Electron bundle rather than our executable, so URLs are only
+// delivered while the application is running.
+func URLSchemes(schemes ...string) Option {
+	return func(ion *Ion) { ion.urlSchemes = schemes }
+}
+
+// FileExtensions declares the file extensions, without the leading period,
+// that the application can open. Files delivered by the system, as well as
+// any with a matching extension found on the command line, are sent as
+// event.AppOpenFile events. The declaration is only made automatically on
+// macOS; on other platforms the installer must associate the extensions with
+// the application's executable.
+func FileExtensions(extensions ...string) Option {
+	return func(ion *Ion) { ion.fileExtensions = extensions }
+}
+
+// CallTimeout sets how long methods that do not take a context wait for
+// Electron to complete each request. Defaults to 30 seconds.
+func CallTimeout(timeout time.Duration) Option {
+	return func(ion *Ion) { ion.callTimeout = timeout }
+}
@@ -0,0 +1,35 @@
+package ion
+
+import "time"
+
+// Possible values returned by SystemIdleState.
+const (
+	IdleStateActive  = "active"
+	IdleStateIdle    = "idle"
+	IdleStateLocked  = "locked"
+	IdleStateUnknown = "unknown"
+)
+
+type idleArgs struct {
+	Threshold int `json:"threshold,omitempty"`
+}
+
+// SystemIdleTime returns the amount of time the system has been without user
+// input.
+func (ion *Ion) SystemIdleTime() (time.Duration, error) {
+	var seconds int
+	if err := ion.invoke("power.idleTime", nil, &seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// SystemIdleState returns one of the IdleState constants. The system is
+// considered idle once it has been without user input for 'threshold'.
+func (ion *Ion) SystemIdleState(threshold time.Duration) (string, error) {
+	var state string
+	if err := ion.invoke("power.idleState", &idleArgs{Threshold: int(threshold / time.Second)}, &state); err != nil {
+		return "", err
+	}
+	return state, nil
+}
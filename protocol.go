@@ -0,0 +1,159 @@
+package ion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// protocolChunkSize is the maximum amount of response body data sent to
+// Electron in a single message.
+const protocolChunkSize = 64 * 1024
+
+type protocolRequest struct {
+	ID      int64             `json:"id"`
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    []byte            `json:"body"`
+}
+
+type protocolArgs struct {
+	ID      int64       `json:"id"`
+	Status  int         `json:"status,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Data    []byte      `json:"data,omitempty"`
+	Aborted bool        `json:"aborted,omitempty"`
+}
+
+// protocolResponseWriter relays a response to Electron. The status and
+// headers are sent with the first write, followed by the body in chunks.
+// Each message waits for Electron to accept it before returning, so the
+// body is streamed rather than buffered.
+type protocolResponseWriter struct {
+	ion    *Ion
+	id     int64
+	header http.Header
+	status int
+	sent   bool
+	err    error
+}
+
+func (ion *Ion) handleProtocolRequest(args json.RawMessage) {
+	var req protocolRequest
+	if err := json.Unmarshal(args, &req); err != nil {
+		ion.logger.Error(errs.NewWithCause("Invalid protocol request", err))
+		// Electron is still waiting on the request, so abort it if its ID
+		// can be recovered.
+		var id struct {
+			ID int64 `json:"id"`
+		}
+		if json.Unmarshal(args, &id) == nil && id.ID != 0 {
+			if err = ion.send(&request{Cmd: "protocol.end", Args: &protocolArgs{ID: id.ID, Aborted: true}}); err != nil {
+				ion.logger.Error(err)
+			}
+		}
+		return
+	}
+	w := &protocolResponseWriter{
+		ion:    ion,
+		id:     req.ID,
+		header: make(http.Header),
+	}
+	defer w.finish()
+	if ion.protocolHandler == nil {
+		http.NotFound(w, nil)
+		return
+	}
+	r, err := http.NewRequest(req.Method, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		ion.logger.Error(errs.Wrap(err))
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	for k, v := range req.Headers {
+		r.Header.Set(k, v)
+	}
+	ion.serveProtocolRequest(w, r.WithContext(ion.ctx))
+}
+
+func (ion *Ion) serveProtocolRequest(w *protocolResponseWriter, r *http.Request) {
+	defer func() {
+		if err := recover(); err != nil {
+			ion.logger.Error(errs.Newf("recovered from panic in protocol handler\n%+v", err))
+			if !w.sent {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}
+	}()
+	ion.protocolHandler.ServeHTTP(w, r)
+}
+
+func (w *protocolResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *protocolResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *protocolResponseWriter) Write(data []byte) (int, error) {
+	if !w.sent {
+		if w.header.Get("Content-Type") == "" {
+			w.header.Set("Content-Type", http.DetectContentType(data))
+		}
+		w.sendHeader()
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	written := 0
+	for len(data) > 0 {
+		n := len(data)
+		if n > protocolChunkSize {
+			n = protocolChunkSize
+		}
+		if w.err = w.ion.call(w.ion.ctx, "protocol.write", &protocolArgs{ID: w.id, Data: data[:n]}, nil); w.err != nil {
+			return written, w.err
+		}
+		written += n
+		data = data[n:]
+	}
+	return written, nil
+}
+
+// Flush implements http.Flusher.
+func (w *protocolResponseWriter) Flush() {
+	if !w.sent {
+		w.sendHeader()
+	}
+}
+
+func (w *protocolResponseWriter) sendHeader() {
+	w.sent = true
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.err = w.ion.call(w.ion.ctx, "protocol.respond", &protocolArgs{ID: w.id, Status: w.status, Headers: w.header}, nil)
+}
+
+func (w *protocolResponseWriter) finish() {
+	if !w.sent {
+		w.sendHeader()
+	}
+	if w.err == nil {
+		if w.err = w.ion.call(w.ion.ctx, "protocol.end", &protocolArgs{ID: w.id}, nil); w.err == nil {
+			return
+		}
+	}
+	w.ion.logger.Error(w.err)
+	// Electron is still waiting on the request, so abort it. No reply is
+	// awaited, since the connection itself may be what failed.
+	if err := w.ion.send(&request{Cmd: "protocol.end", Args: &protocolArgs{ID: w.id, Aborted: true}}); err != nil {
+		w.ion.logger.Error(err)
+	}
+}
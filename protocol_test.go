@@ -0,0 +1,37 @@
+package ion
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/richardwilkes/toolbox/log/logadapter"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+func TestInvalidProtocolRequestIsAborted(t *testing.T) {
+	client, server := net.Pipe()
+	defer xio.CloseIgnoringErrors(client)
+	defer xio.CloseIgnoringErrors(server)
+	ion := &Ion{conn: client, logger: &logadapter.Discarder{}}
+	go ion.handleProtocolRequest(json.RawMessage(`{"id":7,"method":"GET","url":"app://x/","headers":[]}`))
+	if err := server.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	line, err := bufio.NewReader(server).ReadBytes('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg struct {
+		Cmd  string       `json:"cmd"`
+		Args protocolArgs `json:"args"`
+	}
+	if err = json.Unmarshal(line, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Cmd != "protocol.end" || msg.Args.ID != 7 || !msg.Args.Aborted {
+		t.Errorf("expected an aborted protocol.end for request 7, got %s", line)
+	}
+}
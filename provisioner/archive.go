@@ -1,55 +1,60 @@
 package provisioner
 
 import (
-	"archive/zip"
-	"bytes"
-	"os"
-
 	"github.com/richardwilkes/toolbox/errs"
-	xzip "github.com/richardwilkes/toolbox/xio/fs/zip"
+	"github.com/richardwilkes/toolbox/xio"
 )
 
-// DeploymentFinalizer is used to make any final adjustments to files deployed
-// during provisioning before recording their state.
-type DeploymentFinalizer func(dstRootPath string) error
-
 // FromArchive attempts to deploy an archive of files onto the file system.
 // 'finalizer' may be nil.
 func FromArchive(version, dstRootPath string, retriever ArchiveRetriever, finalizer DeploymentFinalizer) error {
-	var crc uint64
-	var err error
-	s := loadStatus(dstRootPath)
-	if s.Version == version {
-		if crc, err = crcTree(dstRootPath); err == nil && crc == s.CRC64 {
-			return nil
+	return FromArchiveWithOptions(version, dstRootPath, retriever, finalizer, nil)
+}
+
+// FromArchiveWithOptions is the same as FromArchive, but also accepts
+// options, which may be nil.
+func FromArchiveWithOptions(version, dstRootPath string, retriever ArchiveRetriever, finalizer DeploymentFinalizer, options *DeployOptions) error {
+	return FromStreamingArchiveWithOptions(version, dstRootPath, StreamingFromArchiveRetriever(retriever), finalizer, options)
+}
+
+// FromStreamingArchive attempts to deploy an archive of files onto the file
+// system. The archive's format is determined from its content; see
+// RegisterArchiveDecoder. A previous deployment of the same version is
+// reused if intact. If files copied verbatim from the archive are missing or
+// modified, only those are extracted again. Deployments within the same
+// provisioning root, the directory containing 'dstRootPath', from other
+// goroutines or processes wait on a lock file within it. 'finalizer' may be
+// nil.
+func FromStreamingArchive(version, dstRootPath string, retriever StreamingArchiveRetriever, finalizer DeploymentFinalizer) error {
+	return FromStreamingArchiveWithOptions(version, dstRootPath, retriever, finalizer, nil)
+}
+
+// FromStreamingArchiveWithOptions is the same as FromStreamingArchive, but
+// also accepts options, which may be nil.
+func FromStreamingArchiveWithOptions(version, dstRootPath string, retriever StreamingArchiveRetriever, finalizer DeploymentFinalizer, options *DeployOptions) error {
+	return deploy(version, dstRootPath, func() (deploymentSource, error) {
+		a, err := retriever()
+		if err != nil {
+			return nil, errs.Wrap(err)
 		}
-	}
-	if err = os.RemoveAll(dstRootPath); err != nil && !os.IsNotExist(err) {
-		return errs.Wrap(err)
-	}
-	if err = os.MkdirAll(dstRootPath, 0755); err != nil {
-		return errs.Wrap(err)
-	}
-	var data []byte
-	if data, err = retriever(); err != nil {
-		return errs.Wrap(err)
-	}
-	var zr *zip.Reader
-	if zr, err = zip.NewReader(bytes.NewReader(data), int64(len(data))); err != nil {
-		return errs.Wrap(err)
-	}
-	if err = xzip.Extract(zr, dstRootPath); err != nil {
-		return errs.Wrap(err)
-	}
-	if finalizer != nil {
-		if err = finalizer(dstRootPath); err != nil {
-			return errs.Wrap(err)
+		decoder, err := archiveDecoderFor(a)
+		if err != nil {
+			xio.CloseIgnoringErrors(a)
+			return nil, err
 		}
-	}
-	if crc, err = crcTree(dstRootPath); err != nil {
-		return err
-	}
-	s.Version = version
-	s.CRC64 = crc
-	return s.save(dstRootPath)
+		return &archiveSource{Archive: a, decoder: decoder}, nil
+	}, finalizer, options)
+}
+
+type archiveSource struct {
+	Archive
+	decoder *ArchiveDecoder
+}
+
+func (s *archiveSource) extractAll(dstRootPath string, progress ProgressReporter) error {
+	return extractArchive(s.decoder, s.Archive, dstRootPath, progress)
+}
+
+func (s *archiveSource) extract(targets map[string][]string) error {
+	return extractArchiveFiles(s.decoder, s.Archive, targets)
 }
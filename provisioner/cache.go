@@ -0,0 +1,248 @@
+package provisioner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/logadapter"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+const (
+	cacheTempPrefix   = ".tmp-"
+	checksumsPrefix   = "checksums-"
+	staleCacheTempAge = 24 * time.Hour
+)
+
+// ArchiveCache stores retrieved archives in a directory that may be shared
+// by every application on the machine, keyed by archive name and SHA-256
+// checksum.
+type ArchiveCache struct {
+	// Dir is the directory archives are stored in.
+	Dir string
+	// MaxSize is the total size, in bytes, the cache is pruned to after an
+	// archive is added. Zero means no limit.
+	MaxSize int64
+	// Logger receives a record of any failure to maintain the cache, none
+	// of which prevent an archive from being retrieved. Defaults to
+	// discarding them.
+	Logger logadapter.Logger
+}
+
+// DefaultArchiveCache returns an ArchiveCache located within the user's cache
+// directory, limited to 512MB.
+func DefaultArchiveCache() (*ArchiveCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ArchiveCache{
+		Dir:     filepath.Join(dir, "ion", "archives"),
+		MaxSize: 512 * 1024 * 1024,
+	}, nil
+}
+
+// Retriever returns a StreamingArchiveRetriever that returns the named
+// archive from the cache if present, otherwise obtains it from 'source' and
+// adds it to the cache. The expected checksum from 'checksums' is used as
+// part of the cache key; if it cannot be determined, the cache is bypassed.
+// Archives whose checksum does not match are never cached, and cached
+// archives that no longer match are discarded.
+func (c *ArchiveCache) Retriever(archiveName string, checksums ChecksumProvider, source StreamingArchiveRetriever) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		checksum, err := checksums(archiveName)
+		if err != nil {
+			return source()
+		}
+		checksum = strings.ToLower(checksum)
+		path := filepath.Join(c.Dir, checksum+"-"+archiveName)
+		if a, openErr := c.open(path, checksum); openErr == nil {
+			return a, nil
+		}
+		a, err := source()
+		if err != nil {
+			return nil, err
+		}
+		if err = c.store(path, a, checksum); err != nil {
+			c.logger().Warnf("Unable to cache %s: %v", archiveName, err)
+		} else if err = c.Prune(); err != nil {
+			c.logger().Warnf("Unable to prune archive cache %s: %v", c.Dir, err)
+		}
+		return a, nil
+	}
+}
+
+// ChecksumsRetriever returns a StreamingArchiveRetriever that returns the
+// named checksums file, such as a release's SHASUMS256.txt, from the cache if
+// present, otherwise obtains it from 'source' and adds it to the cache. The
+// checksums published for a release never change, so keeping them allows
+// archives to be verified later without network access. 'name' should
+// identify the release, as it is also the cache key. A checksums file is only
+// cached, or returned, if it lists 'archiveName', so that a truncated or
+// otherwise bogus response cannot prevent later verification.
+func (c *ArchiveCache) ChecksumsRetriever(name, archiveName string, source StreamingArchiveRetriever) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		path := filepath.Join(c.Dir, checksumsPrefix+name)
+		if a, err := c.open(path, ""); err == nil {
+			if err = checkSHASums(a, archiveName); err == nil {
+				return a, nil
+			}
+			xio.CloseIgnoringErrors(a)
+			c.remove(path)
+		}
+		a, err := source()
+		if err != nil {
+			return nil, err
+		}
+		if err = checkSHASums(a, archiveName); err != nil {
+			xio.CloseIgnoringErrors(a)
+			return nil, err
+		}
+		if err = c.store(path, a, ""); err != nil {
+			c.logger().Warnf("Unable to cache checksums for %s: %v", archiveName, err)
+		}
+		return a, nil
+	}
+}
+
+// checkSHASums returns an error unless 'a' is a SHASUMS256.txt file that
+// lists 'archiveName'.
+func checkSHASums(a Archive, archiveName string) error {
+	checksums, err := parseSHASums(io.NewSectionReader(a, 0, a.Size()))
+	if err != nil {
+		return err
+	}
+	if len(checksums) == 0 {
+		return errs.New("Checksums file contains no entries")
+	}
+	if _, ok := checksums[archiveName]; !ok {
+		return errs.Newf("Checksums file does not list %s", archiveName)
+	}
+	return nil
+}
+
+// open returns a cached file. If 'checksum' is not empty, the file is
+// discarded unless its content matches.
+func (c *ArchiveCache) open(path, checksum string) (Archive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		xio.CloseIgnoringErrors(f)
+		return nil, errs.Wrap(err)
+	}
+	if checksum != "" {
+		h := sha256.New()
+		if _, err = io.Copy(h, f); err != nil || hex.EncodeToString(h.Sum(nil)) != checksum {
+			xio.CloseIgnoringErrors(f)
+			c.remove(path)
+			return nil, errs.Newf("Cached archive %s is damaged", path)
+		}
+	}
+	// Record the use so that pruning removes the least recently used
+	// archives first.
+	now := time.Now()
+	if err = os.Chtimes(path, now, now); err != nil {
+		c.logger().Warnf("Unable to record use of cached archive %s: %v", path, err)
+	}
+	return &fileArchive{ReaderAt: f, Closer: f, size: fi.Size()}, nil
+}
+
+// store copies an archive into the cache, verifying it against 'checksum'
+// unless it is empty. The copy is written to a temporary file and renamed
+// into place, so other processes never see a partial archive.
+func (c *ArchiveCache) store(path string, a Archive, checksum string) error {
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return errs.Wrap(err)
+	}
+	f, err := ioutil.TempFile(c.Dir, cacheTempPrefix)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, h), io.NewSectionReader(a, 0, a.Size()))
+	if cerr := f.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err == nil && checksum != "" && hex.EncodeToString(h.Sum(nil)) != checksum {
+		err = errs.Newf("Checksum mismatch for %s", filepath.Base(path))
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		c.remove(f.Name())
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// remove deletes a file from the cache, logging any failure.
+func (c *ArchiveCache) remove(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		c.logger().Warnf("Unable to remove %s from archive cache: %v", path, err)
+	}
+}
+
+func (c *ArchiveCache) logger() logadapter.Logger {
+	if c.Logger == nil {
+		return &logadapter.Discarder{}
+	}
+	return c.Logger
+}
+
+// Prune removes the least recently used archives until the cache is no
+// larger than MaxSize. Temporary files abandoned by interrupted stores are
+// also removed. Cached checksums files are small and needed to verify
+// archives offline, so they are neither removed nor counted.
+func (c *ArchiveCache) Prune() error {
+	infos, err := ioutil.ReadDir(c.Dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errs.Wrap(err)
+	}
+	var archives []os.FileInfo
+	var total int64
+	for _, fi := range infos {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+		if strings.HasPrefix(fi.Name(), cacheTempPrefix) {
+			if time.Since(fi.ModTime()) > staleCacheTempAge {
+				c.remove(filepath.Join(c.Dir, fi.Name()))
+			}
+			continue
+		}
+		if strings.HasPrefix(fi.Name(), checksumsPrefix) {
+			continue
+		}
+		archives = append(archives, fi)
+		total += fi.Size()
+	}
+	if c.MaxSize <= 0 || total <= c.MaxSize {
+		return nil
+	}
+	sort.Slice(archives, func(i, j int) bool { return archives[i].ModTime().Before(archives[j].ModTime()) })
+	for _, fi := range archives {
+		if total <= c.MaxSize {
+			break
+		}
+		if err = os.Remove(filepath.Join(c.Dir, fi.Name())); err != nil && !os.IsNotExist(err) {
+			return errs.Wrap(err)
+		}
+		total -= fi.Size()
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package provisioner
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readArchive(t *testing.T, a Archive) string {
+	t.Helper()
+	buffer := make([]byte, a.Size())
+	if _, err := a.ReadAt(buffer, 0); err != nil {
+		t.Fatal(err)
+	}
+	return string(buffer)
+}
+
+func TestArchiveCacheRetriever(t *testing.T) {
+	content := []byte("archive content")
+	sum := sha256.Sum256(content)
+	checksums := StaticChecksumProvider(map[string]string{"a.zip": hex.EncodeToString(sum[:])})
+	calls := 0
+	source := func() (Archive, error) {
+		calls++
+		return &bytesArchive{Reader: bytes.NewReader(content)}, nil
+	}
+	cache := &ArchiveCache{Dir: t.TempDir()}
+	retriever := cache.Retriever("a.zip", checksums, source)
+	for i := 0; i < 2; i++ {
+		a, err := retriever()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := readArchive(t, a); s != string(content) {
+			t.Errorf("unexpected content %q", s)
+		}
+		if err = a.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the source to be used once, got %d", calls)
+	}
+
+	// A damaged archive is discarded and retrieved again.
+	path := filepath.Join(cache.Dir, hex.EncodeToString(sum[:])+"-a.zip")
+	if err := ioutil.WriteFile(path, []byte("damaged"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a, err := retriever()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := readArchive(t, a); s != string(content) {
+		t.Errorf("unexpected content %q", s)
+	}
+	if calls != 2 {
+		t.Errorf("expected the damaged archive to be retrieved again, got %d retrievals", calls)
+	}
+}
+
+func TestArchiveCacheRejectsMismatch(t *testing.T) {
+	checksums := StaticChecksumProvider(map[string]string{"a.zip": hex.EncodeToString(make([]byte, sha256.Size))})
+	cache := &ArchiveCache{Dir: t.TempDir()}
+	if _, err := cache.Retriever("a.zip", checksums, func() (Archive, error) {
+		return &bytesArchive{Reader: bytes.NewReader([]byte("unexpected"))}, nil
+	})(); err != nil {
+		t.Fatal(err)
+	}
+	infos, err := ioutil.ReadDir(cache.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 0 {
+		t.Errorf("expected nothing to be cached, found %s", infos[0].Name())
+	}
+}
+
+func TestArchiveCacheBypassedWithoutChecksum(t *testing.T) {
+	cache := &ArchiveCache{Dir: filepath.Join(t.TempDir(), "cache")}
+	if _, err := cache.Retriever("a.zip", func(string) (string, error) {
+		return "", errors.New("offline")
+	}, func() (Archive, error) {
+		return &bytesArchive{Reader: bytes.NewReader([]byte("content"))}, nil
+	})(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(cache.Dir); !os.IsNotExist(err) {
+		t.Error("expected the cache to be bypassed")
+	}
+}
+
+func TestArchiveCachePrune(t *testing.T) {
+	cache := &ArchiveCache{Dir: t.TempDir(), MaxSize: 10}
+	now := time.Now()
+	for i, name := range []string{"oldest", "older", "newest"} {
+		path := filepath.Join(cache.Dir, name)
+		writeTestFile(t, path, "12345")
+		when := now.Add(time.Duration(i-3) * time.Hour)
+		if err := os.Chtimes(path, when, when); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checksums := filepath.Join(cache.Dir, checksumsPrefix+"v1")
+	writeTestFile(t, checksums, "1234567890")
+	ancient := now.Add(-48 * time.Hour)
+	if err := os.Chtimes(checksums, ancient, ancient); err != nil {
+		t.Fatal(err)
+	}
+	staleTemp := filepath.Join(cache.Dir, cacheTempPrefix+"stale")
+	writeTestFile(t, staleTemp, "")
+	stale := now.Add(-2 * staleCacheTempAge)
+	if err := os.Chtimes(staleTemp, stale, stale); err != nil {
+		t.Fatal(err)
+	}
+	freshTemp := filepath.Join(cache.Dir, cacheTempPrefix+"fresh")
+	writeTestFile(t, freshTemp, "")
+	if err := cache.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	for name, kept := range map[string]bool{
+		"oldest":                  false,
+		"older":                   true,
+		"newest":                  true,
+		cacheTempPrefix + "stale": false,
+		cacheTempPrefix + "fresh": true,
+		checksumsPrefix + "v1":    true,
+	} {
+		if _, err := os.Stat(filepath.Join(cache.Dir, name)); kept != (err == nil) {
+			t.Errorf("%s: expected kept to be %v", name, kept)
+		}
+	}
+}
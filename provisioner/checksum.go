@@ -0,0 +1,145 @@
+package provisioner
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// ChecksumProvider returns the expected SHA-256 checksum, hex-encoded, of the
+// named archive.
+type ChecksumProvider func(archiveName string) (string, error)
+
+// StaticChecksumProvider returns a ChecksumProvider that looks up checksums
+// in a table keyed by archive name.
+func StaticChecksumProvider(checksums map[string]string) ChecksumProvider {
+	return func(archiveName string) (string, error) {
+		if checksum, ok := checksums[archiveName]; ok {
+			return checksum, nil
+		}
+		return "", errs.Newf("No known checksum for %s", archiveName)
+	}
+}
+
+// SHASumsChecksumProvider returns a ChecksumProvider that looks up checksums
+// in a SHASUMS256.txt file obtained from 'retriever'. The file is retrieved
+// at most once.
+func SHASumsChecksumProvider(retriever StreamingArchiveRetriever) ChecksumProvider {
+	var once sync.Once
+	var checksums map[string]string
+	var err error
+	return func(archiveName string) (string, error) {
+		once.Do(func() {
+			var a Archive
+			if a, err = retriever(); err != nil {
+				return
+			}
+			defer xio.CloseIgnoringErrors(a)
+			checksums, err = parseSHASums(io.NewSectionReader(a, 0, a.Size()))
+		})
+		if err != nil {
+			return "", err
+		}
+		return StaticChecksumProvider(checksums)(archiveName)
+	}
+}
+
+// FallbackChecksumProvider returns a ChecksumProvider that will try each of
+// the provided ChecksumProviders in turn until one succeeds or none are
+// left.
+func FallbackChecksumProvider(providers ...ChecksumProvider) ChecksumProvider {
+	return func(archiveName string) (string, error) {
+		var cumulativeErr error
+		for _, p := range providers {
+			checksum, err := p(archiveName)
+			if err == nil {
+				return checksum, nil
+			}
+			cumulativeErr = errs.Append(cumulativeErr, err)
+		}
+		return "", cumulativeErr
+	}
+}
+
+// VerifyingStreamingArchiveRetriever returns a StreamingArchiveRetriever
+// that rejects any archive from 'retriever' whose SHA-256 checksum does not
+// match the one 'checksums' provides for 'archiveName'. An archive is also
+// rejected if its expected checksum cannot be determined.
+func VerifyingStreamingArchiveRetriever(retriever StreamingArchiveRetriever, archiveName string, checksums ChecksumProvider) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		a, err := retriever()
+		if err != nil {
+			return nil, err
+		}
+		if err = verifyChecksum(io.NewSectionReader(a, 0, a.Size()), archiveName, checksums); err != nil {
+			xio.CloseIgnoringErrors(a)
+			return nil, err
+		}
+		return a, nil
+	}
+}
+
+// VerifyingArchiveRetriever returns an ArchiveRetriever that rejects any
+// archive from 'retriever' whose SHA-256 checksum does not match the one
+// 'checksums' provides for 'archiveName'. An archive is also rejected if its
+// expected checksum cannot be determined.
+func VerifyingArchiveRetriever(retriever ArchiveRetriever, archiveName string, checksums ChecksumProvider) ArchiveRetriever {
+	return func() ([]byte, error) {
+		data, err := retriever()
+		if err != nil {
+			return nil, err
+		}
+		if err = verifyChecksum(bytes.NewReader(data), archiveName, checksums); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+func verifyChecksum(r io.Reader, archiveName string, checksums ChecksumProvider) error {
+	expected, err := checksums(archiveName)
+	if err != nil {
+		return errs.NewfWithCause(err, "Unable to verify %s", archiveName)
+	}
+	h := sha256.New()
+	if _, err = io.Copy(h, r); err != nil {
+		return errs.Wrap(err)
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		return errs.Newf("Checksum mismatch for %s: expected %s, got %s", archiveName, expected, actual)
+	}
+	return nil
+}
+
+// parseSHASums parses the format produced by sha256sum, where each line
+// holds a hex-encoded checksum followed by a file name, optionally prefixed
+// with '*'. Lines that do not match are ignored.
+func parseSHASums(r io.Reader) (map[string]string, error) {
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && isSHA256(fields[0]) {
+			checksums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return checksums, nil
+}
+
+func isSHA256(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
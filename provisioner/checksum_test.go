@@ -0,0 +1,143 @@
+package provisioner
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testSHASums = `0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef *electron-v3.0.2-darwin-x64.zip
+fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210  electron-v3.0.2-linux-x64.zip
+
+not a checksum line at all
+abc
+`
+
+func TestParseSHASums(t *testing.T) {
+	checksums, err := parseSHASums(strings.NewReader(testSHASums))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"electron-v3.0.2-darwin-x64.zip": "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"electron-v3.0.2-linux-x64.zip":  "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+	}
+	if len(checksums) != len(expected) {
+		t.Errorf("expected %d checksums, got %v", len(expected), checksums)
+	}
+	for name, checksum := range expected {
+		if checksums[name] != checksum {
+			t.Errorf("%s: expected %s, got %s", name, checksum, checksums[name])
+		}
+	}
+}
+
+func bytesRetriever(data string, calls *int) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		*calls++
+		return &bytesArchive{Reader: bytes.NewReader([]byte(data))}, nil
+	}
+}
+
+func TestSHASumsChecksumProvider(t *testing.T) {
+	calls := 0
+	provider := SHASumsChecksumProvider(bytesRetriever(testSHASums, &calls))
+	for i := 0; i < 2; i++ {
+		checksum, err := provider("electron-v3.0.2-linux-x64.zip")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(checksum, "fedcba") {
+			t.Errorf("unexpected checksum %s", checksum)
+		}
+	}
+	if _, err := provider("electron-v3.0.2-win32-x64.zip"); err == nil {
+		t.Error("expected an error for an unlisted archive")
+	}
+	if calls != 1 {
+		t.Errorf("expected SHASUMS256.txt to be retrieved once, got %d", calls)
+	}
+}
+
+func TestFallbackChecksumProvider(t *testing.T) {
+	provider := FallbackChecksumProvider(
+		StaticChecksumProvider(map[string]string{"a.zip": "aa"}),
+		func(string) (string, error) { return "", errors.New("offline") },
+		StaticChecksumProvider(map[string]string{"b.zip": "bb"}),
+	)
+	for name, expected := range map[string]string{"a.zip": "aa", "b.zip": "bb"} {
+		if checksum, err := provider(name); err != nil || checksum != expected {
+			t.Errorf("%s: expected %s, got %s, %v", name, expected, checksum, err)
+		}
+	}
+	if _, err := provider("c.zip"); err == nil {
+		t.Error("expected an error when no provider knows the checksum")
+	}
+}
+
+func TestVerifyingRetrievers(t *testing.T) {
+	content := "archive content"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+	calls := 0
+	for _, test := range []struct {
+		checksums map[string]string
+		ok        bool
+	}{
+		{map[string]string{"a.zip": checksum}, true},
+		{map[string]string{"a.zip": strings.ToUpper(checksum)}, true},
+		{map[string]string{"a.zip": strings.Repeat("0", len(checksum))}, false},
+		{map[string]string{}, false},
+	} {
+		provider := StaticChecksumProvider(test.checksums)
+		_, err := VerifyingStreamingArchiveRetriever(bytesRetriever(content, &calls), "a.zip", provider)()
+		if test.ok != (err == nil) {
+			t.Errorf("streaming %v: expected success to be %v, got %v", test.checksums, test.ok, err)
+		}
+		_, err = VerifyingArchiveRetriever(func() ([]byte, error) { return []byte(content), nil }, "a.zip", provider)()
+		if test.ok != (err == nil) {
+			t.Errorf("%v: expected success to be %v, got %v", test.checksums, test.ok, err)
+		}
+	}
+}
+
+func TestArchiveCacheChecksumsRetriever(t *testing.T) {
+	cache := &ArchiveCache{Dir: t.TempDir()}
+	calls := 0
+	if _, err := cache.ChecksumsRetriever("v1", "electron-v3.0.2-darwin-x64.zip", bytesRetriever(testSHASums, &calls))(); err != nil {
+		t.Fatal(err)
+	}
+	// Once cached, the checksums are available without the source.
+	offline := func() (Archive, error) { return nil, errors.New("offline") }
+	provider := SHASumsChecksumProvider(cache.ChecksumsRetriever("v1", "electron-v3.0.2-darwin-x64.zip", offline))
+	if checksum, err := provider("electron-v3.0.2-darwin-x64.zip"); err != nil || !strings.HasPrefix(checksum, "012345") {
+		t.Errorf("unexpected result %s, %v", checksum, err)
+	}
+	if _, err := cache.ChecksumsRetriever("v2", "electron-v3.0.2-darwin-x64.zip", offline)(); err == nil {
+		t.Error("expected an error for checksums that were never cached")
+	}
+	if calls != 1 {
+		t.Errorf("expected one retrieval, got %d", calls)
+	}
+}
+
+func TestArchiveCacheChecksumsRetrieverRejectsBogusFiles(t *testing.T) {
+	cache := &ArchiveCache{Dir: t.TempDir()}
+	calls := 0
+	for _, bogus := range []string{"", "<html>Service Unavailable</html>", testSHASums} {
+		if _, err := cache.ChecksumsRetriever("v1", "electron-v3.0.2-win32-x64.zip", bytesRetriever(bogus, &calls))(); err == nil {
+			t.Errorf("expected %q to be rejected", bogus)
+		}
+	}
+	// Nothing was cached, so a later, valid response is used.
+	valid := testSHASums + "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff *electron-v3.0.2-win32-x64.zip\n"
+	if _, err := cache.ChecksumsRetriever("v1", "electron-v3.0.2-win32-x64.zip", bytesRetriever(valid, &calls))(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 4 {
+		t.Errorf("expected four retrievals, got %d", calls)
+	}
+}
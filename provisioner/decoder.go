@@ -0,0 +1,261 @@
+package provisioner
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+	"github.com/ulikunitz/xz"
+)
+
+// ArchiveEntry describes a single entry within an archive.
+type ArchiveEntry struct {
+	// Name is the slash-separated path of the entry within the archive.
+	Name string
+	// Mode holds the type and permission bits of the entry.
+	Mode os.FileMode
+	// Link holds the target of a symbolic link or, for a hard link, the
+	// slash-separated path of the regular file it shares content with. That
+	// file always appears earlier in the archive.
+	Link string
+}
+
+// IsHardLink returns true if the entry is a hard link. Hard links have a
+// regular file mode and a Link, and are walked without content.
+func (e *ArchiveEntry) IsHardLink() bool {
+	return e.Mode.IsRegular() && e.Link != ""
+}
+
+// ArchiveWalkFunc is called for each entry in an archive. 'r' provides the
+// content of regular files, other than hard links, and is nil for other
+// entries. It is only valid
+// until the function returns.
+type ArchiveWalkFunc func(entry *ArchiveEntry, r io.Reader) error
+
+// ArchiveDecoder reads a particular archive format.
+type ArchiveDecoder struct {
+	// Name identifies the format, such as "zip" or "tar.gz".
+	Name string
+	// Match returns true if the archive is in this format, usually by
+	// examining its leading bytes.
+	Match func(a Archive) bool
+	// Walk calls 'fn' for each entry in the archive, in order, stopping at
+	// the first error.
+	Walk func(a Archive, fn ArchiveWalkFunc) error
+	// Count, if not nil, returns the number of entries in the archive. It
+	// is used for progress reporting.
+	Count func(a Archive) (int64, error)
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	xzMagic   = []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}
+	tarMagic  = []byte("ustar")
+)
+
+// tarMagicOffset is the position of the magic value within a tar header.
+const tarMagicOffset = 257
+
+var (
+	decoderLock sync.RWMutex
+	decoders    = []*ArchiveDecoder{ZipDecoder(), TarDecoder(), TarGzDecoder(), TarXZDecoder()}
+)
+
+// RegisterArchiveDecoder adds a decoder for an archive format. Decoders are
+// consulted in reverse order of registration, so a decoder may be registered
+// to take precedence over one of the built-in ones, which handle zip, tar,
+// tar.gz and tar.xz.
+func RegisterArchiveDecoder(decoder *ArchiveDecoder) {
+	decoderLock.Lock()
+	decoders = append([]*ArchiveDecoder{decoder}, decoders...)
+	decoderLock.Unlock()
+}
+
+// archiveDecoderFor returns the decoder for the archive's format.
+func archiveDecoderFor(a Archive) (*ArchiveDecoder, error) {
+	decoderLock.RLock()
+	defer decoderLock.RUnlock()
+	for _, decoder := range decoders {
+		if decoder.Match(a) {
+			return decoder, nil
+		}
+	}
+	return nil, errs.New("Unrecognized archive format")
+}
+
+// hasMagic returns true if the archive contains 'magic' at 'offset'.
+func hasMagic(r io.ReaderAt, offset int64, magic []byte) bool {
+	buffer := make([]byte, len(magic))
+	if _, err := r.ReadAt(buffer, offset); err != nil {
+		return false
+	}
+	return bytes.Equal(buffer, magic)
+}
+
+// ZipDecoder returns a decoder for zip archives.
+func ZipDecoder() *ArchiveDecoder {
+	return &ArchiveDecoder{
+		Name: "zip",
+		Match: func(a Archive) bool {
+			_, err := zip.NewReader(a, a.Size())
+			return err == nil
+		},
+		Walk: walkZip,
+		Count: func(a Archive) (int64, error) {
+			zr, err := zip.NewReader(a, a.Size())
+			if err != nil {
+				return 0, errs.Wrap(err)
+			}
+			return int64(len(zr.File)), nil
+		},
+	}
+}
+
+func walkZip(a Archive, fn ArchiveWalkFunc) error {
+	zr, err := zip.NewReader(a, a.Size())
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for _, f := range zr.File {
+		if err = walkZipFile(f, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkZipFile(f *zip.File, fn ArchiveWalkFunc) error {
+	entry := &ArchiveEntry{Name: f.Name, Mode: f.Mode()}
+	if !entry.Mode.IsRegular() && entry.Mode&os.ModeSymlink == 0 {
+		return fn(entry, nil)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer xio.CloseIgnoringErrors(r)
+	if entry.Mode.IsRegular() {
+		return fn(entry, r)
+	}
+	// Zip archives store the target of a symbolic link as its content.
+	target, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	entry.Link = string(target)
+	return fn(entry, nil)
+}
+
+// TarDecoder returns a decoder for uncompressed tar archives.
+func TarDecoder() *ArchiveDecoder {
+	return CompressedTarDecoder("tar", nil, nil)
+}
+
+// TarGzDecoder returns a decoder for gzip-compressed tar archives.
+func TarGzDecoder() *ArchiveDecoder {
+	return CompressedTarDecoder("tar.gz", gzipMagic, func(r io.Reader) (io.Reader, error) {
+		return gzip.NewReader(r)
+	})
+}
+
+// TarXZDecoder returns a decoder for xz-compressed tar archives.
+func TarXZDecoder() *ArchiveDecoder {
+	return CompressedTarDecoder("tar.xz", xzMagic, func(r io.Reader) (io.Reader, error) {
+		return xz.NewReader(r)
+	})
+}
+
+// CompressedTarDecoder returns a decoder for tar archives wrapped in a
+// compression format that is identified by 'magic' at the start of the
+// archive. 'decompress' returns a reader of the decompressed data. If
+// 'magic' is nil, the archive is taken to be uncompressed and 'decompress'
+// is not used.
+func CompressedTarDecoder(name string, magic []byte, decompress func(r io.Reader) (io.Reader, error)) *ArchiveDecoder {
+	open := func(a Archive) (*tar.Reader, error) {
+		var r io.Reader = io.NewSectionReader(a, 0, a.Size())
+		if magic != nil {
+			var err error
+			if r, err = decompress(r); err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
+		return tar.NewReader(r), nil
+	}
+	return &ArchiveDecoder{
+		Name: name,
+		Match: func(a Archive) bool {
+			if magic == nil {
+				return hasMagic(a, tarMagicOffset, tarMagic)
+			}
+			if !hasMagic(a, 0, magic) {
+				return false
+			}
+			// Confirm that a tar archive lies within, rather than some
+			// other compressed content.
+			tr, err := open(a)
+			if err != nil {
+				return false
+			}
+			_, err = tr.Next()
+			return err == nil
+		},
+		Walk: func(a Archive, fn ArchiveWalkFunc) error {
+			tr, err := open(a)
+			if err != nil {
+				return err
+			}
+			// Maps the names of the regular files seen so far to the file
+			// holding their content, which differs for hard links.
+			files := make(map[string]string)
+			for {
+				var hdr *tar.Header
+				if hdr, err = tr.Next(); err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return errs.Wrap(err)
+				}
+				if err = walkTarEntry(hdr, tr, files, fn); err != nil {
+					return err
+				}
+			}
+		},
+	}
+}
+
+func walkTarEntry(hdr *tar.Header, r io.Reader, files map[string]string, fn ArchiveWalkFunc) error {
+	entry := &ArchiveEntry{Name: hdr.Name, Mode: hdr.FileInfo().Mode()}
+	name := cleanEntryName(hdr.Name)
+	switch hdr.Typeflag {
+	case tar.TypeReg, tar.TypeRegA:
+		files[name] = name
+		return fn(entry, r)
+	case tar.TypeDir:
+		return fn(entry, nil)
+	case tar.TypeSymlink:
+		entry.Link = hdr.Linkname
+		return fn(entry, nil)
+	case tar.TypeLink:
+		// A hard link to another hard link is reported as a link to the
+		// file that holds the content, so that consumers need not follow
+		// chains.
+		target, ok := files[cleanEntryName(hdr.Linkname)]
+		if !ok {
+			return errs.Newf("Hard link target '%s' is not present in the archive", hdr.Linkname)
+		}
+		files[name] = target
+		entry.Mode = entry.Mode.Perm()
+		entry.Link = target
+		return fn(entry, nil)
+	default:
+		// Devices, FIFOs and the like have no place in a deployment.
+		return nil
+	}
+}
@@ -0,0 +1,252 @@
+package provisioner
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ulikunitz/xz"
+)
+
+func tarBytes(t *testing.T) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	for _, hdr := range []*tar.Header{
+		{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "dir/file", Typeflag: tar.TypeReg, Mode: 0755, Size: 5},
+		{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "dir/file", Mode: 0777},
+		{Name: "hard", Typeflag: tar.TypeLink, Linkname: "dir/file", Mode: 0644},
+		{Name: "fifo", Typeflag: tar.TypeFifo, Mode: 0644},
+	} {
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte("hello")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func compress(t *testing.T, data []byte, wrap func(w io.Writer) (io.WriteCloser, error)) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	w, err := wrap(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func zipBytes(t *testing.T) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	zw := zip.NewWriter(&buffer)
+	for _, entry := range []struct {
+		name    string
+		mode    os.FileMode
+		content string
+	}{
+		{"dir/", os.ModeDir | 0755, ""},
+		{"dir/file", 0755, "hello"},
+		{"link", os.ModeSymlink | 0777, "dir/file"},
+	} {
+		fh := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		fh.SetMode(entry.mode)
+		w, err := zw.CreateHeader(fh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func archiveOf(data []byte) Archive {
+	return &bytesArchive{Reader: bytes.NewReader(data)}
+}
+
+type walkedEntry struct {
+	mode    os.FileMode
+	link    string
+	content string
+}
+
+func walk(t *testing.T, decoder *ArchiveDecoder, a Archive) map[string]walkedEntry {
+	t.Helper()
+	walked := make(map[string]walkedEntry)
+	if err := decoder.Walk(a, func(entry *ArchiveEntry, r io.Reader) error {
+		we := walkedEntry{mode: entry.Mode, link: entry.Link}
+		if r != nil {
+			data, err := ioutil.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			we.content = string(data)
+		}
+		walked[cleanEntryName(entry.Name)] = we
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return walked
+}
+
+func TestArchiveDecoderFor(t *testing.T) {
+	tarData := tarBytes(t)
+	for name, data := range map[string][]byte{
+		"zip": zipBytes(t),
+		"tar": tarData,
+		"tar.gz": compress(t, tarData, func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		}),
+		"tar.xz": compress(t, tarData, func(w io.Writer) (io.WriteCloser, error) {
+			return xz.NewWriter(w)
+		}),
+	} {
+		decoder, err := archiveDecoderFor(archiveOf(data))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if decoder.Name != name {
+			t.Errorf("%s: identified as %s", name, decoder.Name)
+		}
+	}
+	for name, data := range map[string][]byte{
+		"empty": nil,
+		"junk":  []byte("this is not an archive"),
+		"gzip": compress(t, []byte("plain text"), func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		}),
+		"xz": compress(t, []byte("plain text"), func(w io.Writer) (io.WriteCloser, error) {
+			return xz.NewWriter(w)
+		}),
+	} {
+		if decoder, err := archiveDecoderFor(archiveOf(data)); err == nil {
+			t.Errorf("%s: identified as %s", name, decoder.Name)
+		}
+	}
+}
+
+func TestTarDecoders(t *testing.T) {
+	tarData := tarBytes(t)
+	for _, test := range []struct {
+		decoder *ArchiveDecoder
+		data    []byte
+	}{
+		{TarDecoder(), tarData},
+		{TarGzDecoder(), compress(t, tarData, func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		})},
+		{TarXZDecoder(), compress(t, tarData, func(w io.Writer) (io.WriteCloser, error) {
+			return xz.NewWriter(w)
+		})},
+	} {
+		walked := walk(t, test.decoder, archiveOf(test.data))
+		if len(walked) != 4 {
+			t.Errorf("%s: expected 4 entries, got %d", test.decoder.Name, len(walked))
+		}
+		if e := walked["dir"]; !e.mode.IsDir() {
+			t.Errorf("%s: dir has mode %v", test.decoder.Name, e.mode)
+		}
+		if e := walked["dir/file"]; e.content != "hello" || e.mode != 0755 {
+			t.Errorf("%s: dir/file is %+v", test.decoder.Name, e)
+		}
+		if e := walked["link"]; e.mode&os.ModeSymlink == 0 || e.link != "dir/file" {
+			t.Errorf("%s: link is %+v", test.decoder.Name, e)
+		}
+		// Hard links name their target rather than repeating its content.
+		if e := walked["hard"]; !e.mode.IsRegular() || e.link != "dir/file" || e.content != "" || e.mode != 0644 {
+			t.Errorf("%s: hard is %+v", test.decoder.Name, e)
+		}
+		if _, ok := walked["fifo"]; ok {
+			t.Errorf("%s: fifo was not skipped", test.decoder.Name)
+		}
+	}
+}
+
+func TestTarDecoderMissingHardLinkTarget(t *testing.T) {
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	if err := tw.WriteHeader(&tar.Header{Name: "hard", Typeflag: tar.TypeLink, Linkname: "missing", Mode: 0644}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := TarDecoder().Walk(archiveOf(buffer.Bytes()), func(*ArchiveEntry, io.Reader) error {
+		return nil
+	}); err == nil {
+		t.Error("expected a missing hard link target to be reported")
+	}
+}
+
+func TestZipDecoder(t *testing.T) {
+	decoder := ZipDecoder()
+	a := archiveOf(zipBytes(t))
+	count, err := decoder.Count(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 entries, got %d", count)
+	}
+	walked := walk(t, decoder, a)
+	if e := walked["dir"]; !e.mode.IsDir() {
+		t.Errorf("dir has mode %v", e.mode)
+	}
+	if e := walked["dir/file"]; e.content != "hello" || e.mode != 0755 {
+		t.Errorf("dir/file is %+v", e)
+	}
+	// Zip archives store the target of a link as its content.
+	if e := walked["link"]; e.mode&os.ModeSymlink == 0 || e.link != "dir/file" || e.content != "" {
+		t.Errorf("link is %+v", e)
+	}
+}
+
+func TestRegisterArchiveDecoder(t *testing.T) {
+	decoderLock.Lock()
+	saved := decoders
+	decoderLock.Unlock()
+	defer func() {
+		decoderLock.Lock()
+		decoders = saved
+		decoderLock.Unlock()
+	}()
+	custom := &ArchiveDecoder{
+		Name:  "custom",
+		Match: func(a Archive) bool { return true },
+		Walk:  func(a Archive, fn ArchiveWalkFunc) error { return nil },
+	}
+	RegisterArchiveDecoder(custom)
+	decoder, err := archiveDecoderFor(archiveOf(tarBytes(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoder != custom {
+		t.Errorf("expected the registered decoder to take precedence, got %s", decoder.Name)
+	}
+}
@@ -0,0 +1,301 @@
+package provisioner
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/cmdline"
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/logadapter"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// DeploymentFinalizer is used to make any final adjustments to files deployed
+// during provisioning before recording their state.
+type DeploymentFinalizer func(dstRootPath string) error
+
+// DeployOptions holds optional settings for deployments. Any field may be
+// left as its zero value.
+type DeployOptions struct {
+	// Progress receives progress updates while files are extracted.
+	Progress ProgressReporter
+	// Verification determines how a previous deployment is checked before
+	// being reused.
+	Verification VerificationMode
+	// Logger receives a record of each file repaired or removed when a
+	// previous deployment is found to be damaged. Defaults to discarding
+	// them.
+	Logger logadapter.Logger
+	// Context, if set, limits how long to wait for other deployments within
+	// the same provisioning root to finish. Defaults to waiting
+	// indefinitely.
+	Context context.Context
+}
+
+// deploymentSource provides the files to be deployed.
+type deploymentSource interface {
+	io.Closer
+	// extractAll copies every file into 'dstRootPath'.
+	extractAll(dstRootPath string, progress ProgressReporter) error
+	// extract copies individual files. 'targets' maps the slash-separated
+	// name of each file, relative to the source root, to the paths it
+	// should be copied to.
+	extract(targets map[string][]string) error
+}
+
+const (
+	rootLockName  = ".provisioning.lock"
+	stagingSuffix = ".staging-"
+	oldSuffix     = ".old"
+)
+
+// deploy brings the files at 'dstRootPath' in line with those from the
+// source returned by 'open'. A previous deployment of the same version is
+// reused if intact and repaired in place if only files copied verbatim from
+// the source are damaged. Otherwise, everything is deployed afresh into a
+// sibling staging directory which then replaces 'dstRootPath', so that an
+// interrupted deployment never leaves a partial tree behind. An advisory
+// lock on a file within the provisioning root, the directory containing
+// 'dstRootPath', is held throughout, so concurrent deployments to any
+// destination within that root take turns.
+func deploy(version, dstRootPath string, open func() (deploymentSource, error), finalizer DeploymentFinalizer, options *DeployOptions) error {
+	if options == nil {
+		options = &DeployOptions{}
+	}
+	logger := options.Logger
+	if logger == nil {
+		logger = &logadapter.Discarder{}
+	}
+	// An absolute path ensures the lock, staging directory and recovery all
+	// happen beside the destination, even for a bare relative one.
+	dstRootPath, err := filepath.Abs(dstRootPath)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	parent, base := filepath.Split(dstRootPath)
+	ctx := options.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	lock, err := Lock(ctx, filepath.Join(parent, rootLockName))
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+	// Any staging directories present were abandoned by deployments that
+	// were interrupted, as they would otherwise hold the lock.
+	recoverInterrupted(parent, base, logger)
+	s := loadStatus(dstRootPath)
+	if s.Version == version && s.AppName == cmdline.AppCmdName && len(s.Manifest) != 0 {
+		if actual, err := scanManifest(dstRootPath, options.Verification == FullVerification); err == nil {
+			changed, extraneous := s.Manifest.differences(actual, options.Verification)
+			if len(changed) == 0 && len(extraneous) == 0 {
+				return nil
+			}
+			if changed.repairable() {
+				if err = s.repair(dstRootPath, open, changed, extraneous, logger); err == nil {
+					return nil
+				}
+				logger.Warnf("Unable to repair %s, deploying it again: %v", dstRootPath, err)
+			} else {
+				logger.Infof("Files modified during deployment of %s are damaged, deploying it again", dstRootPath)
+			}
+		}
+	}
+	staging, err := ioutil.TempDir(parent, "."+base+stagingSuffix)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	// TempDir creates the directory accessible only to its owner.
+	if err = os.Chmod(staging, 0755); err != nil {
+		os.RemoveAll(staging)
+		return errs.Wrap(err)
+	}
+	if err = stage(version, staging, dstRootPath, open, finalizer, options.Progress); err != nil {
+		os.RemoveAll(staging)
+		return err
+	}
+	return swap(staging, dstRootPath, logger)
+}
+
+// stage deploys into 'staging' in preparation for it replacing
+// 'dstRootPath'.
+func stage(version, staging, dstRootPath string, open func() (deploymentSource, error), finalizer DeploymentFinalizer, progress ProgressReporter) error {
+	src, err := open()
+	if err != nil {
+		return err
+	}
+	defer xio.CloseIgnoringErrors(src)
+	if progress != nil {
+		// Report against the final location rather than the staging one.
+		original := progress
+		progress = func(ps ProgressStage, _ string, done, total int64) {
+			original(ps, dstRootPath, done, total)
+		}
+	}
+	if err = src.extractAll(staging, progress); err != nil {
+		return err
+	}
+	// The state prior to finalization identifies which files are exact
+	// copies of ones in the source, and so can be repaired later.
+	var extracted manifest
+	if extracted, err = scanManifest(staging, true); err != nil {
+		return err
+	}
+	if finalizer != nil {
+		if err = finalizer(staging); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return (&status{}).record(staging, version, extracted)
+}
+
+// swap replaces 'dstRootPath' with 'staging'. The previous tree is moved
+// aside first, since directories cannot be renamed over one another on all
+// platforms.
+func swap(staging, dstRootPath string, logger logadapter.Logger) error {
+	old := staging + oldSuffix
+	if err := os.Rename(dstRootPath, old); err != nil {
+		if !os.IsNotExist(err) {
+			os.RemoveAll(staging)
+			return errs.Wrap(err)
+		}
+		old = ""
+	}
+	if err := os.Rename(staging, dstRootPath); err != nil {
+		if old != "" {
+			os.Rename(old, dstRootPath)
+		}
+		os.RemoveAll(staging)
+		return errs.Wrap(err)
+	}
+	if old != "" {
+		if err := os.RemoveAll(old); err != nil {
+			logger.Warnf("Unable to remove previous deployment %s: %v", old, err)
+		}
+	}
+	return nil
+}
+
+// recoverInterrupted cleans up after deployments for 'base' within 'parent'
+// that were interrupted. If the destination is missing because the process
+// was killed part way through a swap, the previous deployment that was moved
+// aside is put back. Any other staging directories, along with previous
+// deployments moved aside for them, are removed.
+func recoverInterrupted(parent, base string, logger logadapter.Logger) {
+	infos, err := ioutil.ReadDir(parent)
+	if err != nil {
+		return
+	}
+	dstRootPath := filepath.Join(parent, base)
+	_, err = os.Lstat(dstRootPath)
+	missing := os.IsNotExist(err)
+	prefix := "." + base + stagingSuffix
+	for _, fi := range infos {
+		if !strings.HasPrefix(fi.Name(), prefix) {
+			continue
+		}
+		path := filepath.Join(parent, fi.Name())
+		if missing && strings.HasSuffix(fi.Name(), oldSuffix) {
+			if err = os.Rename(path, dstRootPath); err == nil {
+				missing = false
+				logger.Infof("Restored previous deployment %s from %s", dstRootPath, path)
+				continue
+			}
+			logger.Warnf("Unable to restore previous deployment %s from %s: %v", dstRootPath, path, err)
+		}
+		if err = os.RemoveAll(path); err != nil {
+			logger.Warnf("Unable to remove stale staging directory %s: %v", path, err)
+		} else {
+			logger.Infof("Removed stale staging directory %s", path)
+		}
+	}
+}
+
+// repair restores the 'changed' entries from the source and removes the
+// 'extraneous' paths.
+func (s *status) repair(dstRootPath string, open func() (deploymentSource, error), changed manifest, extraneous []string, logger logadapter.Logger) error {
+	var removed string
+	for _, name := range extraneous {
+		// Entries are in walk order, so the contents of a removed directory
+		// immediately follow it.
+		if removed != "" && strings.HasPrefix(name, removed+"/") {
+			continue
+		}
+		removed = name
+		path := filepath.Join(dstRootPath, filepath.FromSlash(name))
+		if err := os.RemoveAll(path); err != nil {
+			return errs.Wrap(err)
+		}
+		logger.Infof("Removed extraneous %s", path)
+	}
+	targets := make(map[string][]string)
+	var files manifest
+	for _, entry := range changed {
+		path := filepath.Join(dstRootPath, filepath.FromSlash(entry.Path))
+		if entry.Mode.IsDir() {
+			if fi, err := os.Lstat(path); err == nil && !fi.IsDir() {
+				if err = os.Remove(path); err != nil {
+					return errs.Wrap(err)
+				}
+			}
+			if err := os.MkdirAll(path, entry.Mode.Perm()|0700); err != nil {
+				return errs.Wrap(err)
+			}
+			if err := os.Chmod(path, entry.Mode.Perm()); err != nil {
+				return errs.Wrap(err)
+			}
+			logger.Infof("Repaired %s", path)
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return errs.Wrap(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return errs.Wrap(err)
+		}
+		if entry.Mode&os.ModeSymlink != 0 {
+			if err := writeSymlink(entry.Link, path); err != nil {
+				return err
+			}
+			logger.Infof("Repaired %s", path)
+			continue
+		}
+		targets[entry.Source] = append(targets[entry.Source], path)
+		files = append(files, entry)
+	}
+	if len(files) != 0 {
+		src, err := open()
+		if err != nil {
+			return err
+		}
+		defer xio.CloseIgnoringErrors(src)
+		if err = src.extract(targets); err != nil {
+			return err
+		}
+		for _, entry := range files {
+			path := filepath.Join(dstRootPath, filepath.FromSlash(entry.Path))
+			if err = os.Chmod(path, entry.Mode.Perm()); err != nil {
+				return errs.Wrap(err)
+			}
+			var h string
+			if h, err = hashFile(path); err != nil {
+				return err
+			}
+			if h != entry.Hash {
+				return errs.Newf("Restored content of %s does not match", path)
+			}
+			var fi os.FileInfo
+			if fi, err = os.Stat(path); err != nil {
+				return errs.Wrap(err)
+			}
+			entry.ModTime = fi.ModTime().UnixNano()
+			logger.Infof("Repaired %s", path)
+		}
+	}
+	return s.save(dstRootPath)
+}
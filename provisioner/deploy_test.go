@@ -0,0 +1,324 @@
+package provisioner
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingRetriever returns a retriever of a small tar archive, along with
+// the number of times it has been called.
+func countingRetriever(t *testing.T) (StreamingArchiveRetriever, *int32) {
+	t.Helper()
+	a := buildTar(t,
+		tarEntry{name: "dir/", dir: true},
+		tarEntry{name: "dir/file", content: "hello"},
+		tarEntry{name: "top", content: "top"},
+	)
+	var calls int32
+	return func() (Archive, error) {
+		atomic.AddInt32(&calls, 1)
+		return a, nil
+	}, &calls
+}
+
+func checkDeployed(t *testing.T, dst string) {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join(dst, "dir", "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Errorf("unexpected content %q", data)
+	}
+}
+
+func checkOnlyDestination(t *testing.T, parent string) {
+	t.Helper()
+	infos, err := ioutil.ReadDir(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fi := range infos {
+		if fi.Name() != "app" && fi.Name() != rootLockName {
+			t.Errorf("unexpected %s left behind", fi.Name())
+		}
+	}
+}
+
+func TestDeployReusesIntactDeployment(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	retriever, calls := countingRetriever(t)
+	for i := 0; i < 2; i++ {
+		if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkDeployed(t, dst)
+	checkOnlyDestination(t, parent)
+	if *calls != 1 {
+		t.Errorf("expected the archive to be retrieved once, got %d", *calls)
+	}
+	if err := FromStreamingArchive("2", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected a new version to be deployed again, got %d retrievals", *calls)
+	}
+}
+
+func TestDeployRemovesStaleStaging(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	writeTestFile(t, filepath.Join(parent, ".app"+stagingSuffix+"1", "partial"), "x")
+	writeTestFile(t, filepath.Join(parent, ".other"+stagingSuffix+"1", "partial"), "x")
+	retriever, _ := countingRetriever(t)
+	if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	checkDeployed(t, dst)
+	if _, err := os.Stat(filepath.Join(parent, ".app"+stagingSuffix+"1")); !os.IsNotExist(err) {
+		t.Error("stale staging directory was not removed")
+	}
+	if _, err := os.Stat(filepath.Join(parent, ".other"+stagingSuffix+"1")); err != nil {
+		t.Error("staging directory for another destination was removed")
+	}
+}
+
+func TestDeployRecoversInterruptedSwap(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	retriever, calls := countingRetriever(t)
+	if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Simulate a process killed between the two renames of a swap.
+	staging := filepath.Join(parent, ".app"+stagingSuffix+"2")
+	writeTestFile(t, filepath.Join(staging, "new"), "x")
+	if err := os.Rename(dst, staging+oldSuffix); err != nil {
+		t.Fatal(err)
+	}
+	if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	checkDeployed(t, dst)
+	checkOnlyDestination(t, parent)
+	if *calls != 1 {
+		t.Errorf("expected the previous deployment to be restored rather than deployed again, got %d retrievals", *calls)
+	}
+}
+
+func TestDeployConcurrently(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	retriever, calls := countingRetriever(t)
+	var wg sync.WaitGroup
+	errors := make(chan error, 8)
+	for i := 0; i < cap(errors); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errors <- FromStreamingArchive("1", dst, retriever, nil)
+		}()
+	}
+	wg.Wait()
+	close(errors)
+	for err := range errors {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkDeployed(t, dst)
+	checkOnlyDestination(t, parent)
+	if *calls != 1 {
+		t.Errorf("expected the archive to be retrieved once, got %d", *calls)
+	}
+}
+
+func TestDeployRelativeDestination(t *testing.T) {
+	parent := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(parent); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	// Staging must happen beside the destination, not in the temporary
+	// directory, which may be on another device. Pointing the temporary
+	// directory somewhere unusable makes any use of it fail.
+	t.Setenv("TMPDIR", filepath.Join(t.TempDir(), "missing"))
+	retriever, _ := countingRetriever(t)
+	for _, version := range []string{"1", "2"} {
+		if err = FromStreamingArchive(version, "app", retriever, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkDeployed(t, filepath.Join(parent, "app"))
+	checkOnlyDestination(t, parent)
+}
+
+func TestDeployWaitsOnProvisioningRoot(t *testing.T) {
+	parent := t.TempDir()
+	lock, err := Lock(context.Background(), filepath.Join(parent, rootLockName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Unlock()
+	if other, err := TryLock(filepath.Join(parent, rootLockName)); err != nil || other != nil {
+		t.Fatalf("expected the lock to be unavailable, got %v, %v", other, err)
+	}
+	// A deployment to a different destination within the same root must
+	// still wait, and gives up once its context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	retriever, calls := countingRetriever(t)
+	if err = FromStreamingArchiveWithOptions("1", filepath.Join(parent, "other"), retriever, nil, &DeployOptions{Context: ctx}); err == nil {
+		t.Error("expected the deployment to give up waiting for the lock")
+	}
+	if *calls != 0 {
+		t.Errorf("expected nothing to be retrieved, got %d retrievals", *calls)
+	}
+}
+
+func TestDeployFailureKeepsPrevious(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	retriever, _ := countingRetriever(t)
+	if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := FromStreamingArchive("2", dst, retriever, func(string) error {
+		return os.ErrInvalid
+	}); err == nil {
+		t.Fatal("expected the finalizer's error")
+	}
+	checkDeployed(t, dst)
+	checkOnlyDestination(t, parent)
+	if s := loadStatus(dst); s.Version != "1" {
+		t.Errorf("expected version 1 to remain, got %s", s.Version)
+	}
+}
+
+func TestDeployRepairsInPlace(t *testing.T) {
+	skipWithoutSymlinks(t)
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	a := buildTar(t,
+		tarEntry{name: "dir/", dir: true},
+		tarEntry{name: "dir/file", content: "hello"},
+		tarEntry{name: "top", content: "top"},
+		tarEntry{name: "link", link: "top"},
+	)
+	retriever := func() (Archive, error) { return a, nil }
+	finalizer := func(dstRootPath string) error {
+		return ioutil.WriteFile(filepath.Join(dstRootPath, "finalized"), []byte("final"), 0644)
+	}
+	options := &DeployOptions{Verification: FullVerification}
+	if err := FromStreamingArchiveWithOptions("1", dst, retriever, finalizer, options); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.Stat(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Damage only files that came straight from the archive.
+	if err = os.Remove(filepath.Join(dst, "dir", "file")); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dst, "top"), "TOP")
+	if err = os.Remove(filepath.Join(dst, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink("dir", filepath.Join(dst, "link")); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dst, "junk", "file"), "junk")
+	if err = FromStreamingArchiveWithOptions("1", dst, retriever, finalizer, options); err != nil {
+		t.Fatal(err)
+	}
+	after, err := os.Stat(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(before, after) {
+		t.Error("expected the deployment to be repaired in place")
+	}
+	checkDeployed(t, dst)
+	for path, expected := range map[string]string{"top": "top", "link": "top", "finalized": "final"} {
+		data, err := ioutil.ReadFile(filepath.Join(dst, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, data)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dst, "junk")); !os.IsNotExist(err) {
+		t.Error("extraneous directory was not removed")
+	}
+
+	// A file produced by the finalizer cannot be restored from the archive,
+	// so the whole deployment is replaced.
+	writeTestFile(t, filepath.Join(dst, "finalized"), "FINAL")
+	if err = FromStreamingArchiveWithOptions("1", dst, retriever, finalizer, options); err != nil {
+		t.Fatal(err)
+	}
+	if after, err = os.Stat(dst); err != nil {
+		t.Fatal(err)
+	}
+	if os.SameFile(before, after) {
+		t.Error("expected the deployment to be replaced")
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dst, "finalized"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "final" {
+		t.Errorf("unexpected content %q", data)
+	}
+	checkOnlyDestination(t, parent)
+}
+
+func TestDeployQuickVerificationIgnoresContent(t *testing.T) {
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "app")
+	retriever, calls := countingRetriever(t)
+	if err := FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dst, "dir", "file")
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, path, "HELLO")
+	if err = os.Chtimes(path, fi.ModTime(), fi.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	if err = FromStreamingArchive("1", dst, retriever, nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 1 {
+		t.Errorf("expected quick verification to accept the file, got %d retrievals", *calls)
+	}
+	if err = FromStreamingArchiveWithOptions("1", dst, retriever, nil, &DeployOptions{Verification: FullVerification}); err != nil {
+		t.Fatal(err)
+	}
+	checkDeployed(t, dst)
+	if *calls != 2 {
+		t.Errorf("expected full verification to repair the file, got %d retrievals", *calls)
+	}
+}
@@ -0,0 +1,204 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// Downloader retrieves files over HTTP into temporary files. Failed attempts
+// are retried with exponential backoff, resuming from where the previous
+// attempt left off when the server supports range requests.
+type Downloader struct {
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	Client *http.Client
+	// Retries is the number of additional attempts made after the first
+	// one fails.
+	Retries int
+	// InitialBackoff is the delay before the first retry. Each subsequent
+	// retry doubles the delay, up to MaxBackoff. Defaults to one second.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries. Defaults to 30 seconds.
+	MaxBackoff time.Duration
+	// AttemptTimeout limits the duration of each attempt. Zero means no
+	// limit.
+	AttemptTimeout time.Duration
+	// Timeout limits the duration of the download as a whole, including
+	// retries. Zero means no limit.
+	Timeout time.Duration
+	// Progress, if not nil, receives updates as bytes are downloaded.
+	Progress ProgressReporter
+}
+
+// DefaultDownloader returns a Downloader suitable for fetching large
+// archives over unreliable connections.
+func DefaultDownloader() *Downloader {
+	return &Downloader{
+		Retries:        5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		AttemptTimeout: 10 * time.Minute,
+		Timeout:        30 * time.Minute,
+	}
+}
+
+type httpStatusError struct {
+	url    string
+	status int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("Attempted download of %s returned status code %d", e.url, e.status)
+}
+
+// permanent returns true if retrying the request is pointless.
+func (e *httpStatusError) permanent() bool {
+	return e.status >= 400 && e.status < 500 && e.status != http.StatusRequestTimeout && e.status != http.StatusTooManyRequests
+}
+
+// Retriever returns a StreamingArchiveRetriever that downloads from 'url'.
+func (d *Downloader) Retriever(ctx context.Context, url string) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		return d.Download(ctx, url)
+	}
+}
+
+// Download retrieves the contents of 'url' into a temporary file, which will
+// be removed when the returned Archive is closed.
+func (d *Downloader) Download(ctx context.Context, url string) (Archive, error) {
+	if d.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+	}
+	f, err := ioutil.TempFile("", "ion-download-")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	a := &tempFileArchive{File: f}
+	backoff := d.InitialBackoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+	maxBackoff := d.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+	var cumulativeErr error
+	for attempt := 0; ; attempt++ {
+		if err = d.attempt(ctx, url, a); err == nil {
+			return a, nil
+		}
+		cumulativeErr = errs.Append(cumulativeErr, err)
+		if statusErr, ok := err.(*httpStatusError); (ok && statusErr.permanent()) || attempt >= d.Retries || ctx.Err() != nil {
+			break
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			cumulativeErr = errs.Append(cumulativeErr, errs.Wrap(ctx.Err()))
+			break
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+	xio.CloseIgnoringErrors(a)
+	return nil, errs.NewfWithCause(cumulativeErr, "Failed to download %s", url)
+}
+
+func (d *Downloader) attempt(ctx context.Context, url string, a *tempFileArchive) error {
+	if d.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.AttemptTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req = req.WithContext(ctx)
+	if a.size > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", a.size))
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer xio.CloseIgnoringErrors(resp.Body)
+	var total int64
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err = a.reset(); err != nil {
+			return err
+		}
+		total = resp.ContentLength
+	case http.StatusPartialContent:
+		if start, ok := contentRangeStart(resp.Header.Get("Content-Range")); !ok || start != a.size {
+			if err = a.reset(); err != nil {
+				return err
+			}
+			return errs.Newf("Server returned an unexpected range for %s", url)
+		}
+		total = -1
+		if resp.ContentLength >= 0 {
+			total = a.size + resp.ContentLength
+		}
+	default:
+		return &httpStatusError{url: url, status: resp.StatusCode}
+	}
+	if _, err = a.Seek(a.size, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	n, err := io.Copy(a.File, withProgress(resp.Body, d.Progress, DownloadStage, url, a.size, total))
+	a.size += n
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if total >= 0 && a.size != total {
+		return errs.Newf("Download of %s ended after %d of %d bytes", url, a.size, total)
+	}
+	return nil
+}
+
+// reset discards any partially downloaded data.
+func (a *tempFileArchive) reset() error {
+	a.size = 0
+	if err := a.Truncate(0); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// contentRangeStart extracts the first byte position from a Content-Range
+// header of the form "bytes start-end/size".
+func contentRangeStart(contentRange string) (int64, bool) {
+	if !strings.HasPrefix(contentRange, "bytes ") {
+		return 0, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(contentRange, "bytes "), "-", 2)
+	if len(parts) != 2 {
+		return 0, false
+	}
+	start, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return start, true
+}
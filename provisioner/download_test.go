@@ -0,0 +1,237 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testDownload = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+func testDownloader(retries int) *Downloader {
+	return &Downloader{
+		Retries:        retries,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+}
+
+// recordingServer serves requests with 'handler', numbering them from zero
+// and recording their Range headers.
+func recordingServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, n int)) (*httptest.Server, *[]string) {
+	t.Helper()
+	var lock sync.Mutex
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		n := len(ranges)
+		ranges = append(ranges, r.Header.Get("Range"))
+		lock.Unlock()
+		handler(w, r, n)
+	}))
+	t.Cleanup(server.Close)
+	return server, &ranges
+}
+
+// serveTruncated claims the full length but sends only part of the content,
+// so the client sees the connection drop part way through.
+func serveTruncated(w http.ResponseWriter) {
+	w.Header().Set("Content-Length", fmt.Sprint(len(testDownload)))
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, testDownload[:10])
+}
+
+func downloadString(t *testing.T, d *Downloader, url string) (string, error) {
+	t.Helper()
+	a, err := d.Download(context.Background(), url)
+	if err != nil {
+		return "", err
+	}
+	defer a.Close()
+	return readArchive(t, a), nil
+}
+
+func TestDownloadResumes(t *testing.T) {
+	server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n == 0 {
+			serveTruncated(w)
+			return
+		}
+		var start int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &start); err != nil {
+			t.Errorf("unexpected Range header %q", r.Header.Get("Range"))
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(testDownload)-1, len(testDownload)))
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, testDownload[start:])
+	})
+	var lastDone, lastTotal int64
+	d := testDownloader(1)
+	d.Progress = func(stage ProgressStage, _ string, done, total int64) {
+		lastDone, lastTotal = done, total
+	}
+	s, err := downloadString(t, d, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != testDownload {
+		t.Errorf("unexpected content %q", s)
+	}
+	if len(*ranges) != 2 || (*ranges)[1] != "bytes=10-" {
+		t.Errorf("unexpected requests %q", *ranges)
+	}
+	if lastDone != int64(len(testDownload)) || lastTotal != int64(len(testDownload)) {
+		t.Errorf("unexpected final progress %d of %d", lastDone, lastTotal)
+	}
+}
+
+func TestDownloadRestartsWithoutRangeSupport(t *testing.T) {
+	server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n == 0 {
+			serveTruncated(w)
+			return
+		}
+		fmt.Fprint(w, testDownload)
+	})
+	s, err := downloadString(t, testDownloader(1), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != testDownload {
+		t.Errorf("unexpected content %q", s)
+	}
+	if len(*ranges) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(*ranges))
+	}
+}
+
+func TestDownloadRejectsUnexpectedRange(t *testing.T) {
+	server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		switch n {
+		case 0:
+			serveTruncated(w)
+		case 1:
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes 5-%d/%d", len(testDownload)-1, len(testDownload)))
+			w.WriteHeader(http.StatusPartialContent)
+			fmt.Fprint(w, testDownload[5:])
+		default:
+			fmt.Fprint(w, testDownload)
+		}
+	})
+	s, err := downloadString(t, testDownloader(2), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != testDownload {
+		t.Errorf("unexpected content %q", s)
+	}
+	// The mismatched range discards the partial data, so the final attempt
+	// starts over.
+	if len(*ranges) != 3 || (*ranges)[2] != "" {
+		t.Errorf("unexpected requests %q", *ranges)
+	}
+}
+
+func TestDownloadRetries(t *testing.T) {
+	server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, testDownload)
+	})
+	if _, err := downloadString(t, testDownloader(1), server.URL); err == nil {
+		t.Fatal("expected the download to fail once retries are exhausted")
+	}
+	if len(*ranges) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(*ranges))
+	}
+	s, err := downloadString(t, testDownloader(1), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != testDownload {
+		t.Errorf("unexpected content %q", s)
+	}
+}
+
+func TestDownloadPermanentFailure(t *testing.T) {
+	for status, requests := range map[int]int{
+		http.StatusNotFound:        1,
+		http.StatusForbidden:       1,
+		http.StatusTooManyRequests: 3,
+		http.StatusRequestTimeout:  3,
+		http.StatusBadGateway:      3,
+	} {
+		server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+			w.WriteHeader(status)
+		})
+		_, err := downloadString(t, testDownloader(2), server.URL)
+		if err == nil || !strings.Contains(err.Error(), fmt.Sprint(status)) {
+			t.Errorf("%d: unexpected error %v", status, err)
+		}
+		if len(*ranges) != requests {
+			t.Errorf("%d: expected %d requests, got %d", status, requests, len(*ranges))
+		}
+	}
+}
+
+func TestDownloadCancelled(t *testing.T) {
+	server, _ := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	d := testDownloader(100)
+	d.InitialBackoff = time.Hour
+	d.MaxBackoff = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := d.Download(ctx, server.URL); err == nil {
+		t.Fatal("expected the download to be cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("cancellation took %v", elapsed)
+	}
+}
+
+func TestContentRangeStart(t *testing.T) {
+	for header, expected := range map[string]int64{
+		"bytes 0-9/10":    0,
+		"bytes 100-199/*": 100,
+		"bytes  7-8/9":    7,
+		"bytes */10":      -1,
+		"items 0-9/10":    -1,
+		"bytes x-9/10":    -1,
+		"":                -1,
+	} {
+		start, ok := contentRangeStart(header)
+		if ok != (expected >= 0) || (ok && start != expected) {
+			t.Errorf("%q: expected %d, got %d, %v", header, expected, start, ok)
+		}
+	}
+}
+
+func TestURLArchiveRetrieverRetries(t *testing.T) {
+	server, ranges := recordingServer(t, func(w http.ResponseWriter, r *http.Request, n int) {
+		if n == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, testDownload)
+	})
+	data, err := URLArchiveRetriever(http.DefaultClient, server.URL)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != testDownload {
+		t.Errorf("unexpected content %q", data)
+	}
+	if len(*ranges) != 2 {
+		t.Errorf("expected two requests, got %d", len(*ranges))
+	}
+}
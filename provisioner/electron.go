@@ -2,65 +2,246 @@ package provisioner
 
 import (
 	"bytes"
+	"context"
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
 
 	"github.com/richardwilkes/toolbox/cmdline"
 	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/logadapter"
 	"github.com/richardwilkes/toolbox/xio"
 )
 
 const (
-	// ElectronVersion holds the version of Electron that will be used.
+	// ElectronVersion holds the version of Electron that will be used unless
+	// another is requested.
 	ElectronVersion = "3.0.2"
 	// ElectronName holds the name of Electron.
 	ElectronName = "Electron"
 )
 
+// ElectronChecksums holds the known SHA-256 checksums of Electron archives,
+// keyed by archive name, as published in the release's SHASUMS256.txt.
+// Archives not listed here are verified against a cached copy of the
+// release's SHASUMS256.txt, which is downloaded if not yet cached. An
+// application that must provision offline on first run, for example from a
+// bundled archive, should add the checksum of that archive here.
+var ElectronChecksums = map[string]string{}
+
+const (
+	// ElectronMirrorEnv holds the name of the environment variable that may
+	// be used to override the base URL Electron is downloaded from.
+	ElectronMirrorEnv     = "ELECTRON_MIRROR"
+	electronDefaultMirror = "https://github.com/electron/electron/releases/download/"
+)
+
 const (
-	appSuffix           = ".app"
-	stringMarker        = "<string>"
-	contentsName        = "Contents"
-	frameworksName      = "Frameworks"
-	plistName           = "Info.plist"
-	macOSName           = "MacOS"
-	electronBundleID    = "com.github.electron"
-	electronLowerName   = "electron"
-	electronApp         = ElectronName + appSuffix
-	helper              = " Helper"
-	electronHelper      = ElectronName + helper
-	electronHelperApp   = electronHelper + appSuffix
-	ehSuffix            = " EH"
-	electronHelperEH    = electronHelper + ehSuffix
-	electronHelperEHApp = electronHelperEH + appSuffix
-	npSuffix            = " NP"
-	electronHelperNP    = electronHelper + npSuffix
-	electronHelperNPApp = electronHelperNP + appSuffix
+	appSuffix         = ".app"
+	stringMarker      = "<string>"
+	contentsName      = "Contents"
+	frameworksName    = "Frameworks"
+	plistName         = "Info.plist"
+	macOSName         = "MacOS"
+	electronBundleID  = "com.github.electron"
+	electronLowerName = "electron"
+	electronApp       = ElectronName + appSuffix
+	helper            = " Helper"
+	electronHelper    = ElectronName + helper
 )
 
+// electronBundleIDs holds the bundle identifiers used by the various Electron
+// releases, which changed capitalization over time.
+var electronBundleIDs = []string{electronBundleID, "com.github.Electron"}
+
 // ElectronPath returns the path to the root of the Electron installation.
 func ElectronPath(rootPath string) string {
 	return filepath.Join(rootPath, electronLowerName)
 }
 
-// ElectronArchiveName returns the name of the Electron archive file.
+// ElectronRelease identifies a build of Electron. Any field may be left empty
+// to use the default for the current machine.
+type ElectronRelease struct {
+	// Version is the Electron version, without a leading 'v'. Defaults to
+	// ElectronVersion.
+	Version string
+	// Platform is Electron's name for the operating system: "darwin",
+	// "linux" or "win32".
+	Platform string
+	// Arch is Electron's name for the architecture: "x64", "ia32", "arm64"
+	// or "armv7l". Electron's only 32-bit ARM builds are for ARMv7, so
+	// executables built with GOARM below 7 default to an unsupported
+	// architecture; set "armv7l" explicitly if the machine is ARMv7.
+	Arch string
+}
+
+var (
+	electronPlatforms = map[string]string{
+		"darwin":  "darwin",
+		"linux":   "linux",
+		"windows": "win32",
+	}
+	electronArchs = map[string]string{
+		"amd64": "x64",
+		"386":   "ia32",
+		"arm64": "arm64",
+		"arm":   "armv7l",
+	}
+	// electronSupported holds the architectures Electron publishes builds
+	// for on each platform.
+	electronSupported = map[string][]string{
+		"darwin": {"x64", "arm64"},
+		"linux":  {"x64", "ia32", "arm64", "armv7l"},
+		"win32":  {"x64", "ia32", "arm64"},
+	}
+	// electronFirstMajor holds the first major version of Electron built for
+	// combinations that were added after the others.
+	electronFirstMajor = map[string]int{
+		"darwin-arm64": 11,
+		"win32-arm64":  6,
+	}
+	// electronLastMajor holds the last major version of Electron built for
+	// combinations that have since been dropped.
+	electronLastMajor = map[string]int{
+		"linux-ia32": 18,
+	}
+)
+
+// ElectronArchiveName returns the name of the Electron archive file for the
+// default release.
 func ElectronArchiveName() string {
-	return fmt.Sprintf("%s-v%s-%s-%s.zip", electronLowerName, ElectronVersion, electronOS(), electronArch())
+	return ElectronRelease{}.ArchiveName()
 }
 
-// ElectronDownloadURL returns the URL to use for downloading Electron.
+// ElectronDownloadURL returns the URL to use for downloading the default
+// release of Electron, from the mirror named by the ELECTRON_MIRROR
+// environment variable if set.
 func ElectronDownloadURL() string {
-	return fmt.Sprintf("https://github.com/%s/%s/releases/download/v%s/%s", electronLowerName, electronLowerName, ElectronVersion, ElectronArchiveName())
+	return ElectronRelease{}.DownloadURL("")
+}
+
+// ElectronChecksumsURL returns the URL of the SHASUMS256.txt file for the
+// default release of Electron, from the mirror named by the ELECTRON_MIRROR
+// environment variable if set.
+func ElectronChecksumsURL() string {
+	return ElectronRelease{}.ChecksumsURL("")
+}
+
+// ArchiveName returns the name of the archive file for the release.
+func (r ElectronRelease) ArchiveName() string {
+	r = r.withDefaults()
+	return fmt.Sprintf("%s-v%s-%s-%s.zip", electronLowerName, r.Version, r.Platform, r.Arch)
+}
+
+// DownloadURL returns the URL to use for downloading the release. 'mirror'
+// is the base URL of the release downloads. If empty, the ELECTRON_MIRROR
+// environment variable is consulted, then GitHub is used.
+func (r ElectronRelease) DownloadURL(mirror string) string {
+	r = r.withDefaults()
+	return electronReleaseURL(mirror, r.Version, r.ArchiveName())
+}
+
+// ChecksumsURL returns the URL of the SHASUMS256.txt file for the release.
+// 'mirror' is treated as for DownloadURL.
+func (r ElectronRelease) ChecksumsURL(mirror string) string {
+	r = r.withDefaults()
+	return electronReleaseURL(mirror, r.Version, "SHASUMS256.txt")
 }
 
-// ElectronExecutablePath returns the path to the Electron executable.
+// Validate returns an error if Electron is not built for the release's
+// platform and architecture.
+func (r ElectronRelease) Validate() error {
+	r = r.withDefaults()
+	archs, ok := electronSupported[r.Platform]
+	if !ok {
+		return errs.Newf("Electron is not available for the %s platform", r.Platform)
+	}
+	supported := false
+	for _, arch := range archs {
+		if arch == r.Arch {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		if strings.HasPrefix(r.Arch, "armv") {
+			return errs.Newf("Electron is not available for %s; its 32-bit ARM builds require armv7l", r.Arch)
+		}
+		return errs.Newf("Electron is not available for the %s architecture on the %s platform", r.Arch, r.Platform)
+	}
+	combination := r.Platform + "-" + r.Arch
+	first, hasFirst := electronFirstMajor[combination]
+	last, hasLast := electronLastMajor[combination]
+	if !hasFirst && !hasLast {
+		return nil
+	}
+	major, err := strconv.Atoi(strings.SplitN(r.Version, ".", 2)[0])
+	if err != nil {
+		return errs.Newf("Invalid Electron version: %s", r.Version)
+	}
+	if hasFirst && major < first {
+		return errs.Newf("Electron %s is not available for %s; version %d or later is required", r.Version, combination, first)
+	}
+	if hasLast && major > last {
+		return errs.Newf("Electron %s is not available for %s; version %d was the last built for it", r.Version, combination, last)
+	}
+	return nil
+}
+
+func (r ElectronRelease) withDefaults() ElectronRelease {
+	if r.Version == "" {
+		r.Version = ElectronVersion
+	}
+	r.Version = strings.TrimPrefix(r.Version, "v")
+	if r.Platform == "" {
+		r.Platform = electronOS(runtime.GOOS)
+	}
+	if r.Arch == "" {
+		r.Arch = electronArch(runtime.GOARCH, goarm())
+	}
+	return r
+}
+
+// goos returns the Go name for the release's operating system.
+func (r ElectronRelease) goos() string {
+	for goos, platform := range electronPlatforms {
+		if platform == r.Platform {
+			return goos
+		}
+	}
+	return r.Platform
+}
+
+func electronReleaseURL(mirror, version, name string) string {
+	if mirror == "" {
+		if mirror = os.Getenv(ElectronMirrorEnv); mirror == "" {
+			mirror = electronDefaultMirror
+		}
+	}
+	if !strings.HasSuffix(mirror, "/") {
+		mirror += "/"
+	}
+	return fmt.Sprintf("%sv%s/%s", mirror, version, name)
+}
+
+// ElectronExecutablePath returns the path to the Electron executable for the
+// default release.
 func ElectronExecutablePath(rootPath string) string {
+	return ElectronRelease{}.ExecutablePath(rootPath)
+}
+
+// ExecutablePath returns the path to the Electron executable for the
+// release.
+func (r ElectronRelease) ExecutablePath(rootPath string) string {
 	electronPath := ElectronPath(rootPath)
-	switch runtime.GOOS {
+	switch r.withDefaults().goos() {
 	case "darwin":
 		return filepath.Join(electronPath, cmdline.AppCmdName+appSuffix, contentsName, macOSName, cmdline.AppCmdName)
 	case "windows":
@@ -70,49 +251,202 @@ func ElectronExecutablePath(rootPath string) string {
 	}
 }
 
-func electronOS() string {
-	if runtime.GOOS == "windows" {
-		return "win32"
+// electronOS returns Electron's name for a GOOS value. Unknown values are
+// returned unchanged.
+func electronOS(goos string) string {
+	if platform, ok := electronPlatforms[goos]; ok {
+		return platform
+	}
+	return goos
+}
+
+// electronArch returns Electron's name for a GOARCH value. Unknown values
+// are returned unchanged. For "arm", an 'armVersion' (the GOARM value) below
+// 7 yields the name of the matching ARM variant, such as "armv6l", for which
+// Electron has no builds. An 'armVersion' of zero means the variant is
+// unknown and ARMv7 is assumed.
+func electronArch(goarch string, armVersion int) string {
+	if goarch == "arm" && armVersion > 0 && armVersion < 7 {
+		return fmt.Sprintf("armv%dl", armVersion)
+	}
+	if arch, ok := electronArchs[goarch]; ok {
+		return arch
 	}
-	return runtime.GOOS
+	return goarch
 }
 
-func electronArch() string {
-	if runtime.GOARCH == "amd64" {
-		return "x64"
+// goarm returns the GOARM value the executable was built with, or zero if it
+// cannot be determined.
+func goarm() int {
+	if runtime.GOARCH != "arm" {
+		return 0
 	}
-	return "ia32"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "GOARM" && setting.Value != "" {
+				// Later Go releases may append a floating point mode, as
+				// in "7,softfloat".
+				if v, err := strconv.Atoi(strings.SplitN(setting.Value, ",", 2)[0]); err == nil {
+					return v
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// Associations holds the URL schemes and file extensions (without the leading
+// period) that the application handles. On macOS, these must be declared in
+// the application bundle for the system to deliver them.
+type Associations struct {
+	URLSchemes     []string
+	FileExtensions []string
+}
+
+// ElectronOptions holds optional settings for ProvisionElectron. Any field
+// may be left as its zero value.
+type ElectronOptions struct {
+	// MacOSAppBundleID is the value to use for the CFBundleIdentifier in
+	// plists.
+	MacOSAppBundleID string
+	// IconFS is used to retrieve icon files.
+	IconFS http.FileSystem
+	// Associations holds the URL schemes and file extensions to declare.
+	Associations *Associations
+	// ArchiveRetriever, if set, is tried before downloading.
+	ArchiveRetriever StreamingArchiveRetriever
+	// Downloader is used to download the archive and its checksums.
+	// Defaults to DefaultDownloader().
+	Downloader *Downloader
+	// Mirror is the base URL of the release downloads. See
+	// ElectronRelease.DownloadURL.
+	Mirror string
+	// Progress receives progress updates. It is also used by the Downloader
+	// if the Downloader does not have its own.
+	Progress ProgressReporter
+	// Release selects the version, platform and architecture of Electron
+	// to provision.
+	Release ElectronRelease
+	// Verification determines how a previous deployment is checked before
+	// being reused.
+	Verification VerificationMode
+	// Logger receives a record of any files repaired, along with any
+	// problems using the archive cache.
+	Logger logadapter.Logger
+	// Cache holds downloaded archives for reuse by other applications.
+	// Defaults to DefaultArchiveCache().
+	Cache *ArchiveCache
+	// DisableCache prevents the use of any archive cache.
+	DisableCache bool
 }
 
-// ProvisionElectron attempts to provision Electron. 'iconFS' may be nil. If
-// 'archiveRetriever' is not nil, it will be tried before the GitHub
-// retriever.
-func ProvisionElectron(rootPath, macOSAppBundleID string, iconFS http.FileSystem, archiveRetriever ArchiveRetriever) error {
-	r := URLArchiveRetriever(&http.Client{}, ElectronDownloadURL())
-	if archiveRetriever != nil {
-		r = FallbackArchiveRetriever(archiveRetriever, r)
+// ProvisionElectron attempts to provision Electron. 'options' may be nil. An
+// error is returned if Electron is not built for the requested platform and
+// architecture.
+// Archives from either the options' ArchiveRetriever or the download are
+// rejected unless their checksum matches the one found in ElectronChecksums
+// or the release's SHASUMS256.txt. Downloaded archives and SHASUMS256.txt
+// files are kept in the options' Cache so that other applications needing
+// the same archive can skip the download, and so that later provisioning can
+// verify archives without network access. Cancelling 'ctx' aborts any
+// download in progress.
+func ProvisionElectron(ctx context.Context, rootPath string, options *ElectronOptions) error {
+	if options == nil {
+		options = &ElectronOptions{}
+	}
+	downloader := DefaultDownloader()
+	if options.Downloader != nil {
+		d := *options.Downloader
+		downloader = &d
+	}
+	if downloader.Progress == nil {
+		downloader.Progress = options.Progress
+	}
+	release := options.Release.withDefaults()
+	if err := release.Validate(); err != nil {
+		return err
+	}
+	logger := options.Logger
+	if logger == nil {
+		logger = &logadapter.Discarder{}
+	}
+	var cache *ArchiveCache
+	if !options.DisableCache {
+		if options.Cache != nil {
+			c := *options.Cache
+			cache = &c
+		} else {
+			var err error
+			if cache, err = DefaultArchiveCache(); err != nil {
+				logger.Warnf("Unable to use the default archive cache, continuing without one: %v", err)
+			}
+		}
+		if cache != nil && cache.Logger == nil {
+			cache.Logger = logger
+		}
+	}
+	name := release.ArchiveName()
+	shaSums := downloader.Retriever(ctx, release.ChecksumsURL(options.Mirror))
+	if cache != nil {
+		shaSums = cache.ChecksumsRetriever(fmt.Sprintf("%s-v%s-SHASUMS256.txt", electronLowerName, release.Version), name, shaSums)
+	}
+	checksums := FallbackChecksumProvider(StaticChecksumProvider(ElectronChecksums), SHASumsChecksumProvider(shaSums))
+	r := VerifyingStreamingArchiveRetriever(downloader.Retriever(ctx, release.DownloadURL(options.Mirror)), name, checksums)
+	if cache != nil {
+		r = cache.Retriever(name, checksums, r)
+	}
+	if options.ArchiveRetriever != nil {
+		r = FallbackStreamingArchiveRetriever(VerifyingStreamingArchiveRetriever(options.ArchiveRetriever, name, checksums), r)
 	}
+	macOSAppBundleID := options.MacOSAppBundleID
 	if macOSAppBundleID == "" {
 		macOSAppBundleID = electronBundleID // Default back to no change
 	}
-	return FromArchive(ElectronVersion, ElectronPath(rootPath), r, func(dstRootPath string) error {
-		return electronDeploymentFinalizer(dstRootPath, macOSAppBundleID, iconFS)
+	iconFS := options.IconFS
+	associations := options.Associations
+	if associations == nil {
+		associations = &Associations{}
+	}
+	// The release and associations are folded into the version so that
+	// changing them causes the bundle to be redeployed.
+	version := release.Version + "-" + release.Platform + "-" + release.Arch
+	if key := associations.key(); key != "" {
+		version += "+" + key
+	}
+	goos := release.goos()
+	return FromStreamingArchiveWithOptions(version, ElectronPath(rootPath), r, func(dstRootPath string) error {
+		return electronDeploymentFinalizer(dstRootPath, goos, macOSAppBundleID, iconFS, associations)
+	}, &DeployOptions{
+		Progress:     options.Progress,
+		Verification: options.Verification,
+		Logger:       options.Logger,
+		Context:      ctx,
 	})
 }
 
-func electronDeploymentFinalizer(dstRootPath, macOSAppBundleID string, iconFS http.FileSystem) error {
-	if err := electronUpdateIcon(dstRootPath, iconFS); err != nil {
+func (a *Associations) key() string {
+	if len(a.URLSchemes) == 0 && len(a.FileExtensions) == 0 {
+		return ""
+	}
+	return strings.Join(a.URLSchemes, ",") + ";" + strings.Join(a.FileExtensions, ",")
+}
+
+func electronDeploymentFinalizer(dstRootPath, goos, macOSAppBundleID string, iconFS http.FileSystem, associations *Associations) error {
+	if err := electronUpdateIcon(dstRootPath, goos, iconFS); err != nil {
+		return err
+	}
+	if err := electronUpdatePLists(dstRootPath, goos, macOSAppBundleID); err != nil {
 		return err
 	}
-	if err := electronUpdatePLists(dstRootPath, macOSAppBundleID); err != nil {
+	if err := electronAddAssociations(dstRootPath, goos, macOSAppBundleID, associations); err != nil {
 		return err
 	}
-	return electronRenameFiles(dstRootPath)
+	return electronRenameFiles(dstRootPath, goos)
 }
 
-func electronUpdateIcon(dstRootPath string, iconFS http.FileSystem) error {
+func electronUpdateIcon(dstRootPath, goos string, iconFS http.FileSystem) error {
 	if iconFS != nil {
-		if runtime.GOOS == "darwin" {
+		if goos == "darwin" {
 			if f, err := iconFS.Open("/app.icns"); err == nil {
 				defer xio.CloseIgnoringErrors(f)
 				data, err := ioutil.ReadAll(f)
@@ -128,26 +462,55 @@ func electronUpdateIcon(dstRootPath string, iconFS http.FileSystem) error {
 	return nil
 }
 
-func electronUpdatePLists(dstRootPath, macOSAppBundleID string) error {
-	if runtime.GOOS == "darwin" {
+// electronHelperSuffixes returns the suffixes that distinguish the helper
+// applications within the Frameworks directory, such as " EH" and " NP" for
+// "Electron Helper EH.app" and "Electron Helper NP.app" in early releases,
+// or " (GPU)" and " (Renderer)" in later ones. The plain "Electron
+// Helper.app" yields an empty suffix.
+func electronHelperSuffixes(frameworksDir, name string) ([]string, error) {
+	infos, err := ioutil.ReadDir(frameworksDir)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	prefix := name + helper
+	var suffixes []string
+	for _, fi := range infos {
+		if fi.IsDir() && strings.HasPrefix(fi.Name(), prefix) && strings.HasSuffix(fi.Name(), appSuffix) {
+			suffixes = append(suffixes, strings.TrimSuffix(strings.TrimPrefix(fi.Name(), prefix), appSuffix))
+		}
+	}
+	if len(suffixes) == 0 {
+		return nil, errs.Newf("No helper applications found in %s", frameworksDir)
+	}
+	return suffixes, nil
+}
+
+func electronUpdatePLists(dstRootPath, goos, macOSAppBundleID string) error {
+	if goos == "darwin" {
 		contentsDir := filepath.Join(dstRootPath, electronApp, contentsName)
 		frameworksDir := filepath.Join(contentsDir, frameworksName)
+		suffixes, err := electronHelperSuffixes(frameworksDir, ElectronName)
+		if err != nil {
+			return err
+		}
 		lookForElectron := []byte(stringMarker + ElectronName)
 		replaceWithAppName := []byte(stringMarker + cmdline.AppCmdName)
-		lookForBundleID := []byte(stringMarker + electronBundleID)
 		replaceWithBundleID := []byte(stringMarker + macOSAppBundleID)
-		for _, path := range []string{
-			filepath.Join(contentsDir, plistName),
-			filepath.Join(frameworksDir, electronHelperEHApp, contentsName, plistName),
-			filepath.Join(frameworksDir, electronHelperNPApp, contentsName, plistName),
-			filepath.Join(frameworksDir, electronHelperApp, contentsName, plistName),
-		} {
+		paths := []string{filepath.Join(contentsDir, plistName)}
+		for _, suffix := range suffixes {
+			paths = append(paths, filepath.Join(frameworksDir, electronHelper+suffix+appSuffix, contentsName, plistName))
+		}
+		for _, path := range paths {
 			buffer, err := ioutil.ReadFile(path)
 			if err != nil {
 				return errs.Wrap(err)
 			}
 			buffer = bytes.Replace(buffer, lookForElectron, replaceWithAppName, -1)
-			buffer = bytes.Replace(buffer, lookForBundleID, replaceWithBundleID, -1)
+			if macOSAppBundleID != electronBundleID {
+				for _, id := range electronBundleIDs {
+					buffer = bytes.Replace(buffer, []byte(stringMarker+id), replaceWithBundleID, -1)
+				}
+			}
 			if err = ioutil.WriteFile(path, buffer, 0644); err != nil {
 				return errs.Wrap(err)
 			}
@@ -156,19 +519,67 @@ func electronUpdatePLists(dstRootPath, macOSAppBundleID string) error {
 	return nil
 }
 
-func electronRenameFiles(dstRootPath string) error {
+func electronAddAssociations(dstRootPath, goos, macOSAppBundleID string, associations *Associations) error {
+	if goos != "darwin" || associations.key() == "" {
+		return nil
+	}
+	var buffer bytes.Buffer
+	if len(associations.URLSchemes) != 0 {
+		buffer.WriteString("\t<key>CFBundleURLTypes</key>\n\t<array>\n\t\t<dict>\n\t\t\t<key>CFBundleURLName</key>\n\t\t\t")
+		writePListString(&buffer, macOSAppBundleID)
+		buffer.WriteString("\t\t\t<key>CFBundleURLSchemes</key>\n\t\t\t<array>\n")
+		for _, scheme := range associations.URLSchemes {
+			buffer.WriteString("\t\t\t\t")
+			writePListString(&buffer, scheme)
+		}
+		buffer.WriteString("\t\t\t</array>\n\t\t</dict>\n\t</array>\n")
+	}
+	if len(associations.FileExtensions) != 0 {
+		buffer.WriteString("\t<key>CFBundleDocumentTypes</key>\n\t<array>\n\t\t<dict>\n\t\t\t<key>CFBundleTypeExtensions</key>\n\t\t\t<array>\n")
+		for _, ext := range associations.FileExtensions {
+			buffer.WriteString("\t\t\t\t")
+			writePListString(&buffer, ext)
+		}
+		buffer.WriteString("\t\t\t</array>\n\t\t\t<key>CFBundleTypeRole</key>\n\t\t\t<string>Editor</string>\n\t\t</dict>\n\t</array>\n")
+	}
+	path := filepath.Join(dstRootPath, electronApp, contentsName, plistName)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	i := bytes.LastIndex(data, []byte("</dict>"))
+	if i == -1 {
+		return errs.Newf("Unable to locate the top-level dictionary in %s", path)
+	}
+	data = append(data[:i], append(buffer.Bytes(), data[i:]...)...)
+	if err = ioutil.WriteFile(path, data, 0644); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func writePListString(buffer *bytes.Buffer, s string) {
+	buffer.WriteString(stringMarker)
+	if err := xml.EscapeText(buffer, []byte(s)); err != nil {
+		buffer.WriteString(s)
+	}
+	buffer.WriteString("</string>\n")
+}
+
+func electronRenameFiles(dstRootPath, goos string) error {
 	type rename struct {
 		src string
 		dst string
 	}
 	var list []rename
-	switch runtime.GOOS {
+	switch goos {
 	case "darwin":
 		appDir := filepath.Join(dstRootPath, cmdline.AppCmdName+appSuffix)
 		frameworksDir := filepath.Join(appDir, contentsName, frameworksName)
-		helperEH := filepath.Join(frameworksDir, cmdline.AppCmdName+helper+ehSuffix+appSuffix)
-		helperNP := filepath.Join(frameworksDir, cmdline.AppCmdName+helper+npSuffix+appSuffix)
-		helperPath := filepath.Join(frameworksDir, cmdline.AppCmdName+helper+appSuffix)
+		suffixes, err := electronHelperSuffixes(filepath.Join(dstRootPath, electronApp, contentsName, frameworksName), ElectronName)
+		if err != nil {
+			return err
+		}
 		list = []rename{
 			{
 				src: filepath.Join(dstRootPath, electronApp),
@@ -178,29 +589,19 @@ func electronRenameFiles(dstRootPath string) error {
 				src: filepath.Join(appDir, contentsName, macOSName, ElectronName),
 				dst: filepath.Join(appDir, contentsName, macOSName, cmdline.AppCmdName),
 			},
-			{
-				src: filepath.Join(frameworksDir, electronHelperEHApp),
-				dst: helperEH,
-			},
-			{
-				src: filepath.Join(helperEH, contentsName, macOSName, electronHelperEH),
-				dst: filepath.Join(helperEH, contentsName, macOSName, cmdline.AppCmdName+helper+ehSuffix),
-			},
-			{
-				src: filepath.Join(frameworksDir, electronHelperNPApp),
-				dst: helperNP,
-			},
-			{
-				src: filepath.Join(helperNP, contentsName, macOSName, electronHelperNP),
-				dst: filepath.Join(helperNP, contentsName, macOSName, cmdline.AppCmdName+helper+npSuffix)},
-			{
-				src: filepath.Join(frameworksDir, electronHelperApp),
-				dst: helperPath,
-			},
-			{
-				src: filepath.Join(helperPath, contentsName, macOSName, electronHelper),
-				dst: filepath.Join(helperPath, contentsName, macOSName, cmdline.AppCmdName+helper),
-			},
+		}
+		for _, suffix := range suffixes {
+			helperPath := filepath.Join(frameworksDir, cmdline.AppCmdName+helper+suffix+appSuffix)
+			list = append(list,
+				rename{
+					src: filepath.Join(frameworksDir, electronHelper+suffix+appSuffix),
+					dst: helperPath,
+				},
+				rename{
+					src: filepath.Join(helperPath, contentsName, macOSName, electronHelper+suffix),
+					dst: filepath.Join(helperPath, contentsName, macOSName, cmdline.AppCmdName+helper+suffix),
+				},
+			)
 		}
 	case "linux":
 		list = []rename{
@@ -0,0 +1,153 @@
+package provisioner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/richardwilkes/toolbox/cmdline"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func helperPList(name, id string) string {
+	return "<plist><dict><key>CFBundleExecutable</key><string>" + name + "</string><key>CFBundleIdentifier</key><string>" + id + "</string></dict></plist>\n"
+}
+
+func TestElectronDarwinFinalizer(t *testing.T) {
+	for _, test := range []struct {
+		version  string
+		id       string
+		suffixes []string
+	}{
+		{"3", "com.github.electron", []string{"", " EH", " NP"}},
+		{"11", "com.github.Electron", []string{"", " (GPU)", " (Plugin)", " (Renderer)"}},
+	} {
+		t.Run(test.version, func(t *testing.T) {
+			rootPath := t.TempDir()
+			root := ElectronPath(rootPath)
+			contents := filepath.Join(root, electronApp, contentsName)
+			writeTestFile(t, filepath.Join(contents, plistName), helperPList(ElectronName, test.id))
+			writeTestFile(t, filepath.Join(contents, macOSName, ElectronName), "")
+			writeTestFile(t, filepath.Join(contents, frameworksName, "Electron Framework.framework", "Electron Framework"), "")
+			for _, suffix := range test.suffixes {
+				helperContents := filepath.Join(contents, frameworksName, electronHelper+suffix+appSuffix, contentsName)
+				writeTestFile(t, filepath.Join(helperContents, plistName), helperPList(electronHelper+suffix, test.id+".helper"))
+				writeTestFile(t, filepath.Join(helperContents, macOSName, electronHelper+suffix), "")
+			}
+			if err := electronDeploymentFinalizer(root, "darwin", "com.example.app", nil, &Associations{}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := os.Stat(ElectronRelease{Platform: "darwin"}.ExecutablePath(rootPath)); err != nil {
+				t.Fatal(err)
+			}
+			contents = filepath.Join(root, cmdline.AppCmdName+appSuffix, contentsName)
+			for _, suffix := range test.suffixes {
+				name := cmdline.AppCmdName + helper + suffix
+				helperContents := filepath.Join(contents, frameworksName, name+appSuffix, contentsName)
+				if _, err := os.Stat(filepath.Join(helperContents, macOSName, name)); err != nil {
+					t.Error(err)
+				}
+				data, err := ioutil.ReadFile(filepath.Join(helperContents, plistName))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if expected := helperPList(name, "com.example.app.helper"); string(data) != expected {
+					t.Errorf("expected %s, got %s", expected, data)
+				}
+			}
+		})
+	}
+}
+
+func TestElectronDarwinFinalizerWithoutHelpers(t *testing.T) {
+	root := t.TempDir()
+	contents := filepath.Join(root, electronApp, contentsName)
+	writeTestFile(t, filepath.Join(contents, plistName), helperPList(ElectronName, electronBundleID))
+	if err := os.MkdirAll(filepath.Join(contents, frameworksName), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := electronDeploymentFinalizer(root, "darwin", "", nil, &Associations{}); err == nil {
+		t.Error("expected an error when no helper applications are present")
+	}
+}
+
+func TestElectronExecutablePath(t *testing.T) {
+	for platform, expected := range map[string]string{
+		"darwin": filepath.Join("root", electronLowerName, cmdline.AppCmdName+appSuffix, contentsName, macOSName, cmdline.AppCmdName),
+		"win32":  filepath.Join("root", electronLowerName, cmdline.AppCmdName+".exe"),
+		"linux":  filepath.Join("root", electronLowerName, cmdline.AppCmdName),
+	} {
+		if path := (ElectronRelease{Platform: platform}).ExecutablePath("root"); path != expected {
+			t.Errorf("%s: expected %s, got %s", platform, expected, path)
+		}
+	}
+}
+
+func TestElectronReleaseValidate(t *testing.T) {
+	for _, test := range []struct {
+		release ElectronRelease
+		ok      bool
+	}{
+		{ElectronRelease{Version: "3.0.2", Platform: "darwin", Arch: "x64"}, true},
+		{ElectronRelease{Version: "3.0.2", Platform: "darwin", Arch: "arm64"}, false},
+		{ElectronRelease{Version: "v11.0.0", Platform: "darwin", Arch: "arm64"}, true},
+		{ElectronRelease{Version: "5.0.0", Platform: "win32", Arch: "arm64"}, false},
+		{ElectronRelease{Version: "6.0.0", Platform: "win32", Arch: "arm64"}, true},
+		{ElectronRelease{Version: "3.0.2", Platform: "linux", Arch: "armv7l"}, true},
+		{ElectronRelease{Version: "3.0.2", Platform: "win32", Arch: "armv7l"}, false},
+		{ElectronRelease{Version: "3.0.2", Platform: "linux", Arch: "armv6l"}, false},
+		{ElectronRelease{Version: "18.3.15", Platform: "linux", Arch: "ia32"}, true},
+		{ElectronRelease{Version: "19.0.0", Platform: "linux", Arch: "ia32"}, false},
+		{ElectronRelease{Version: "19.0.0", Platform: "win32", Arch: "ia32"}, true},
+		{ElectronRelease{Version: "3.0.2", Platform: "freebsd", Arch: "x64"}, false},
+		{ElectronRelease{Version: "latest", Platform: "darwin", Arch: "arm64"}, false},
+	} {
+		err := test.release.Validate()
+		if test.ok != (err == nil) {
+			t.Errorf("%+v: expected validity to be %v, got %v", test.release, test.ok, err)
+		}
+	}
+}
+
+func TestElectronArch(t *testing.T) {
+	for _, test := range []struct {
+		goarch   string
+		goarm    int
+		expected string
+	}{
+		{"amd64", 0, "x64"},
+		{"arm64", 0, "arm64"},
+		{"arm", 0, "armv7l"},
+		{"arm", 7, "armv7l"},
+		{"arm", 6, "armv6l"},
+		{"arm", 5, "armv5l"},
+	} {
+		if arch := electronArch(test.goarch, test.goarm); arch != test.expected {
+			t.Errorf("%s with GOARM=%d: expected %s, got %s", test.goarch, test.goarm, test.expected, arch)
+		}
+	}
+}
+
+func TestElectronReleaseURLs(t *testing.T) {
+	release := ElectronRelease{Version: "v11.1.0", Platform: "darwin", Arch: "arm64"}
+	if name := release.ArchiveName(); name != "electron-v11.1.0-darwin-arm64.zip" {
+		t.Errorf("unexpected archive name %s", name)
+	}
+	if url := release.DownloadURL("https://example.com/mirror"); url != "https://example.com/mirror/v11.1.0/electron-v11.1.0-darwin-arm64.zip" {
+		t.Errorf("unexpected download URL %s", url)
+	}
+	if url := release.ChecksumsURL("https://example.com/mirror/"); !strings.HasSuffix(url, "/mirror/v11.1.0/SHASUMS256.txt") {
+		t.Errorf("unexpected checksums URL %s", url)
+	}
+}
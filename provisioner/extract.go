@@ -0,0 +1,269 @@
+package provisioner
+
+import (
+	"io"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// extractArchive extracts the contents of an archive into 'dstRootPath',
+// reporting each entry to 'progress', which may be nil.
+func extractArchive(decoder *ArchiveDecoder, a Archive, dstRootPath string, progress ProgressReporter) error {
+	total := int64(-1)
+	if decoder.Count != nil {
+		if count, err := decoder.Count(a); err == nil {
+			total = count
+		}
+	}
+	if progress != nil {
+		progress(ExtractStage, dstRootPath, 0, total)
+	}
+	var done int64
+	return decoder.Walk(a, func(entry *ArchiveEntry, r io.Reader) error {
+		if err := extractEntry(entry, r, dstRootPath); err != nil {
+			return err
+		}
+		if done++; progress != nil {
+			progress(ExtractStage, dstRootPath, done, total)
+		}
+		return nil
+	})
+}
+
+func extractEntry(entry *ArchiveEntry, r io.Reader, dstRootPath string) error {
+	path, err := extractionPath(dstRootPath, entry.Name)
+	if err != nil {
+		return err
+	}
+	if err = checkParents(dstRootPath, path); err != nil {
+		return err
+	}
+	mode := entry.Mode
+	if mode.IsDir() {
+		if err = os.MkdirAll(path, mode.Perm()|0700); err != nil {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errs.Wrap(err)
+	}
+	if mode&os.ModeSymlink != 0 {
+		if err = checkLinkTarget(dstRootPath, path, entry.Link); err != nil {
+			return err
+		}
+		return writeSymlink(entry.Link, path)
+	}
+	if !mode.IsRegular() {
+		return nil
+	}
+	if entry.IsHardLink() {
+		// The target was extracted earlier, so its content is copied rather
+		// than read from the archive again.
+		var src string
+		if src, err = extractionPath(dstRootPath, entry.Link); err != nil {
+			return err
+		}
+		var fi os.FileInfo
+		if fi, err = os.Lstat(src); err != nil || !fi.Mode().IsRegular() {
+			return errs.Newf("Hard link target '%s' was not extracted", entry.Link)
+		}
+		return copyLocalFile(src, path, mode.Perm())
+	}
+	return writeFile(r, path, mode.Perm())
+}
+
+// extractArchiveFiles extracts individual files from an archive. 'targets'
+// maps the cleaned, slash-separated name of each file to the paths it should
+// be written to.
+func extractArchiveFiles(decoder *ArchiveDecoder, a Archive, targets map[string][]string) error {
+	// Hard links whose target was not among the files wanted, keyed by that
+	// target. Targets precede their links, so they are extracted by a second
+	// pass over the archive.
+	links := make(map[string][]string)
+	extracted := make(map[string]string)
+	for pass := 0; pass < 2 && len(targets) != 0; pass++ {
+		if err := decoder.Walk(a, func(entry *ArchiveEntry, r io.Reader) error {
+			name := cleanEntryName(entry.Name)
+			dsts, ok := targets[name]
+			if !ok || !entry.Mode.IsRegular() {
+				return nil
+			}
+			delete(targets, name)
+			if entry.IsHardLink() {
+				src, ok := extracted[entry.Link]
+				if !ok {
+					links[entry.Link] = append(links[entry.Link], dsts...)
+					return nil
+				}
+				return copyLocalFiles(src, dsts, entry.Mode.Perm())
+			}
+			if err := writeFile(r, dsts[0], entry.Mode.Perm()); err != nil {
+				return err
+			}
+			extracted[name] = dsts[0]
+			return copyLocalFiles(dsts[0], dsts[1:], entry.Mode.Perm())
+		}); err != nil {
+			return err
+		}
+		for target, dsts := range links {
+			targets[target] = append(targets[target], dsts...)
+		}
+		links = make(map[string][]string)
+	}
+	for name := range targets {
+		return errs.Newf("'%s' is not present in the archive", name)
+	}
+	return nil
+}
+
+// cleanEntryName returns the path, relative to the destination, that an
+// archive entry is extracted to.
+func cleanEntryName(name string) string {
+	return strings.TrimPrefix(pathpkg.Clean("/"+name), "/")
+}
+
+// extractionPath returns the destination for an archive entry, rejecting any
+// entry that would land outside of 'dstRootPath'.
+func extractionPath(dstRootPath, name string) (string, error) {
+	path := filepath.Join(dstRootPath, filepath.FromSlash(name))
+	if path != dstRootPath && !strings.HasPrefix(path, filepath.Clean(dstRootPath)+string(filepath.Separator)) {
+		return "", errs.Newf("Archive entry '%s' is outside of the destination", name)
+	}
+	return path, nil
+}
+
+// checkParents rejects an entry whose parent directories, as they already
+// exist within 'dstRootPath', include a symbolic link, since writing through
+// one could place files outside of the destination.
+func checkParents(dstRootPath, path string) error {
+	root := filepath.Clean(dstRootPath)
+	rel, err := filepath.Rel(root, filepath.Dir(path))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if rel == "." {
+		return nil
+	}
+	current := root
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		fi, err := os.Lstat(current)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return errs.Wrap(err)
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return errs.Newf("Archive entry '%s' lies beneath the symbolic link '%s'", path, current)
+		}
+	}
+	return nil
+}
+
+// maxLinkDepth limits the number of symbolic links followed while checking a
+// link's target.
+const maxLinkDepth = 255
+
+// checkLinkTarget rejects symbolic links that would point outside of
+// 'dstRootPath'. The target is resolved one component at a time, following
+// any links already on disk, as the operating system would.
+func checkLinkTarget(dstRootPath, path, target string) error {
+	if filepath.IsAbs(target) {
+		return errs.Newf("Symbolic link '%s' has an absolute target", path)
+	}
+	root := filepath.Clean(dstRootPath)
+	current := filepath.Dir(path)
+	remaining := strings.Split(filepath.FromSlash(target), string(filepath.Separator))
+	followed := 0
+	for len(remaining) != 0 {
+		part := remaining[0]
+		remaining = remaining[1:]
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			current = filepath.Dir(current)
+		default:
+			current = filepath.Join(current, part)
+		}
+		if current != root && !strings.HasPrefix(current, root+string(filepath.Separator)) {
+			return errs.Newf("Symbolic link '%s' points outside of the destination", path)
+		}
+		if current == root {
+			continue
+		}
+		fi, err := os.Lstat(current)
+		if err != nil || fi.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+		if followed++; followed > maxLinkDepth {
+			return errs.Newf("Symbolic link '%s' has too many levels of indirection", path)
+		}
+		var link string
+		if link, err = os.Readlink(current); err != nil {
+			return errs.Wrap(err)
+		}
+		if filepath.IsAbs(link) {
+			return errs.Newf("Symbolic link '%s' leads through one with an absolute target", path)
+		}
+		current = filepath.Dir(current)
+		remaining = append(strings.Split(filepath.FromSlash(link), string(filepath.Separator)), remaining...)
+	}
+	return nil
+}
+
+func writeSymlink(target, path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errs.Wrap(err)
+	}
+	if err := os.Symlink(target, path); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// writeFile writes the content of 'r' to 'path', refusing to write through a
+// symbolic link.
+func writeFile(r io.Reader, path string, perm os.FileMode) (err error) {
+	if fi, lerr := os.Lstat(path); lerr == nil && fi.Mode()&os.ModeSymlink != 0 {
+		return errs.Newf("Refusing to write through the symbolic link '%s'", path)
+	}
+	var file *os.File
+	if file, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm|0200); err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if cerr := file.Close(); cerr != nil && err == nil {
+			err = errs.Wrap(cerr)
+		}
+	}()
+	if _, err = io.Copy(file, r); err != nil {
+		err = errs.Wrap(err)
+	}
+	return
+}
+
+func copyLocalFile(src, dst string, perm os.FileMode) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer xio.CloseIgnoringErrors(f)
+	return writeFile(f, dst, perm)
+}
+
+func copyLocalFiles(src string, dsts []string, perm os.FileMode) error {
+	for _, dst := range dsts {
+		if err := copyLocalFile(src, dst, perm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
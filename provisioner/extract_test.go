@@ -0,0 +1,245 @@
+package provisioner
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+type tarEntry struct {
+	name    string
+	link    string
+	content string
+	dir     bool
+	hard    bool
+}
+
+func buildTar(t *testing.T, entries ...tarEntry) Archive {
+	t.Helper()
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	for _, entry := range entries {
+		hdr := &tar.Header{Name: entry.name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(entry.content))}
+		switch {
+		case entry.dir:
+			hdr.Typeflag = tar.TypeDir
+			hdr.Mode = 0755
+		case entry.hard:
+			hdr.Typeflag = tar.TypeLink
+			hdr.Linkname = entry.link
+			hdr.Size = 0
+		case entry.link != "":
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = entry.link
+			hdr.Size = 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(entry.content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &bytesArchive{Reader: bytes.NewReader(buffer.Bytes())}
+}
+
+func skipWithoutSymlinks(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("symbolic links require special privileges on windows")
+	}
+}
+
+// extractTar extracts the entries into a "root" directory within a fresh
+// temporary directory, returning both.
+func extractTar(t *testing.T, entries ...tarEntry) (dir, root string, err error) {
+	t.Helper()
+	dir = t.TempDir()
+	root = filepath.Join(dir, "root")
+	if err = os.Mkdir(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+	err = extractArchive(TarDecoder(), buildTar(t, entries...), root, nil)
+	return dir, root, err
+}
+
+func TestExtractArchive(t *testing.T) {
+	skipWithoutSymlinks(t)
+	_, root, err := extractTar(t,
+		tarEntry{name: "dir/", dir: true},
+		tarEntry{name: "dir/file", content: "hello"},
+		tarEntry{name: "./dir/../top", content: "top"},
+		tarEntry{name: "link", link: "dir/file"},
+		tarEntry{name: "sub/up", link: "../dir"},
+		tarEntry{name: "sub/self", link: "."},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, expected := range map[string]string{
+		"dir/file":    "hello",
+		"top":         "top",
+		"link":        "hello",
+		"sub/up/file": "hello",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, data)
+		}
+	}
+}
+
+func TestExtractHardLinks(t *testing.T) {
+	entries := []tarEntry{
+		{name: "dir/file", content: "hello"},
+		{name: "other", content: "other"},
+		{name: "hard", link: "dir/file", hard: true},
+		{name: "harder", link: "./hard", hard: true},
+	}
+	_, root, err := extractTar(t, entries...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"hard", "harder"} {
+		data, err := ioutil.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "hello" {
+			t.Errorf("%s: expected %q, got %q", path, "hello", data)
+		}
+	}
+	// Only the links are wanted here, so their target has to be found by a
+	// second pass.
+	dir := t.TempDir()
+	targets := map[string][]string{
+		"harder": {filepath.Join(dir, "a")},
+		"other":  {filepath.Join(dir, "b")},
+	}
+	if err = extractArchiveFiles(TarDecoder(), buildTar(t, entries...), targets); err != nil {
+		t.Fatal(err)
+	}
+	for path, expected := range map[string]string{"a": "hello", "b": "other"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, data)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "dir")); err == nil {
+		t.Error("the link target was extracted in its own right")
+	}
+}
+
+func TestExtractRejectsEscapes(t *testing.T) {
+	skipWithoutSymlinks(t)
+	for _, test := range []struct {
+		name    string
+		entries []tarEntry
+	}{
+		{"parent", []tarEntry{{name: "../outside", content: "x"}}},
+		{"nested parent", []tarEntry{{name: "a/../../outside", content: "x"}}},
+		{"absolute link", []tarEntry{{name: "l", link: "/etc"}}},
+		{"escaping link", []tarEntry{{name: "l", link: "../outside"}}},
+		{"nested escaping link", []tarEntry{{name: "a/b/l", link: "../../../outside"}}},
+		// A link to the root makes a later link look deeper than it is.
+		{"link below link", []tarEntry{
+			{name: "d", link: "."},
+			{name: "d/e/l", link: "../../outside"},
+		}},
+		// A link that only escapes once an existing link is followed.
+		{"link through link", []tarEntry{
+			{name: "sub/a", link: ".."},
+			{name: "b", link: "sub/a/../outside"},
+		}},
+		{"file below link", []tarEntry{
+			{name: "d", link: "."},
+			{name: "d/f", content: "x"},
+		}},
+		{"file over link", []tarEntry{
+			{name: "f", link: "g"},
+			{name: "f", content: "x"},
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			dir, root, err := extractTar(t, test.entries...)
+			if err == nil {
+				t.Fatal("expected the archive to be rejected")
+			}
+			infos, err := ioutil.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, fi := range infos {
+				if fi.Name() != "root" {
+					t.Errorf("%s was written outside of the destination", fi.Name())
+				}
+			}
+			if _, err = os.Stat(filepath.Join(root, "g")); err == nil {
+				t.Error("a file was written through a symbolic link")
+			}
+		})
+	}
+}
+
+func TestCheckLinkTarget(t *testing.T) {
+	skipWithoutSymlinks(t)
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join("..", ".."), filepath.Join(dir, "a", "b", "up")); err != nil {
+		t.Fatal(err)
+	}
+	for target, ok := range map[string]bool{
+		".":                true,
+		"a/b":              true,
+		"a/b/up":           true,
+		"a/b/up/a":         true,
+		"a/b/up/..":        false,
+		"a/b/../../..":     false,
+		"missing/../a":     true,
+		"../x":             false,
+		"a/b/up/a/b/up/..": false,
+	} {
+		err := checkLinkTarget(dir, filepath.Join(dir, "l"), target)
+		if ok != (err == nil) {
+			t.Errorf("%s: expected acceptance to be %v, got %v", target, ok, err)
+		}
+	}
+}
+
+func TestExtractionPath(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator)+"tmp", "root")
+	for name, ok := range map[string]bool{
+		"a":          true,
+		"a/b/../c":   true,
+		".":          true,
+		"../a":       false,
+		"a/../../b":  false,
+		"../rootish": false,
+	} {
+		path, err := extractionPath(root, name)
+		if ok != (err == nil) {
+			t.Errorf("%s: expected acceptance to be %v, got %v", name, ok, err)
+		}
+		if err == nil && path != root && !strings.HasPrefix(path, root+string(filepath.Separator)) {
+			t.Errorf("%s: resolved to %s", name, path)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/richardwilkes/toolbox/errs"
@@ -11,27 +12,40 @@ import (
 	"github.com/richardwilkes/toolbox/xio/fs"
 )
 
-// FromFileSystem attempts to deploy files from a file system.
+// FromFileSystem attempts to deploy files from a file system. A previous
+// deployment of the same version is reused if intact. If files copied
+// verbatim from the file system are missing or modified, only those are
+// copied again. Deployments within the same provisioning root, the directory
+// containing 'dstRootPath', from other goroutines or processes wait on a lock
+// file within it. 'finalizer' may be nil.
 func FromFileSystem(version, srcRootPath, dstRootPath string, filesystem http.FileSystem, finalizer DeploymentFinalizer) error {
-	var crc uint64
-	var err error
-	s := loadStatus(dstRootPath)
-	if s.Version == version {
-		if crc, err = crcTree(dstRootPath); err == nil && crc == s.CRC64 {
-			return nil
-		}
-	}
-	if err = os.RemoveAll(dstRootPath); err != nil && !os.IsNotExist(err) {
-		return errs.Wrap(err)
-	}
-	if err = os.MkdirAll(dstRootPath, 0755); err != nil {
-		return errs.Wrap(err)
-	}
-	if err = fs.Walk(filesystem, srcRootPath, func(path string, info os.FileInfo, e error) error {
+	return FromFileSystemWithOptions(version, srcRootPath, dstRootPath, filesystem, finalizer, nil)
+}
+
+// FromFileSystemWithOptions is the same as FromFileSystem, but also accepts
+// options, which may be nil.
+func FromFileSystemWithOptions(version, srcRootPath, dstRootPath string, filesystem http.FileSystem, finalizer DeploymentFinalizer, options *DeployOptions) error {
+	return deploy(version, dstRootPath, func() (deploymentSource, error) {
+		return &fileSystemSource{filesystem: filesystem, srcRootPath: srcRootPath}, nil
+	}, finalizer, options)
+}
+
+type fileSystemSource struct {
+	filesystem  http.FileSystem
+	srcRootPath string
+}
+
+func (f *fileSystemSource) Close() error {
+	return nil
+}
+
+func (f *fileSystemSource) extractAll(dstRootPath string, progress ProgressReporter) error {
+	var count int64
+	if err := fs.Walk(f.filesystem, f.srcRootPath, func(path string, info os.FileInfo, e error) error {
 		if e != nil {
 			return e
 		}
-		dst, err2 := filepath.Rel(srcRootPath, path)
+		dst, err2 := filepath.Rel(f.srcRootPath, path)
 		if err2 != nil {
 			dst = path
 		}
@@ -41,25 +55,29 @@ func FromFileSystem(version, srcRootPath, dstRootPath string, filesystem http.Fi
 				return errs.Wrap(err)
 			}
 		} else {
-			if err := copyFile(filesystem, path, dst); err != nil {
+			if err := copyFile(f.filesystem, path, dst); err != nil {
 				return err
 			}
+			if count++; progress != nil {
+				progress(ExtractStage, dstRootPath, count, -1)
+			}
 		}
 		return nil
 	}); err != nil {
 		return errs.Wrap(err)
 	}
-	if finalizer != nil {
-		if err = finalizer(dstRootPath); err != nil {
-			return errs.Wrap(err)
+	return nil
+}
+
+func (f *fileSystemSource) extract(targets map[string][]string) error {
+	for name, dsts := range targets {
+		for _, dst := range dsts {
+			if err := copyFile(f.filesystem, path.Join(f.srcRootPath, name), dst); err != nil {
+				return err
+			}
 		}
 	}
-	if crc, err = crcTree(dstRootPath); err != nil {
-		return err
-	}
-	s.Version = version
-	s.CRC64 = crc
-	return s.save(dstRootPath)
+	return nil
 }
 
 func copyFile(filesystem http.FileSystem, src, dst string) (err error) {
@@ -0,0 +1,96 @@
+package provisioner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+const (
+	lockInitialPoll = 10 * time.Millisecond
+	lockMaxPoll     = 500 * time.Millisecond
+)
+
+// FileLock is an advisory, cross-process lock backed by a file.
+type FileLock struct {
+	file *os.File
+}
+
+// Lock acquires an exclusive lock on the file at 'path', creating it and its
+// parent directories if necessary. Waits until the lock is available or
+// 'ctx' is done, in which case the context's error is returned.
+func Lock(ctx context.Context, path string) (*FileLock, error) {
+	f, err := openLockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	poll := lockInitialPoll
+	for {
+		var locked bool
+		if locked, err = tryLockFile(f); err != nil {
+			xio.CloseIgnoringErrors(f)
+			return nil, errs.NewfWithCause(err, "Unable to lock %s", path)
+		}
+		if locked {
+			return &FileLock{file: f}, nil
+		}
+		timer := time.NewTimer(poll)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			xio.CloseIgnoringErrors(f)
+			return nil, errs.NewfWithCause(ctx.Err(), "Gave up waiting for lock %s", path)
+		}
+		if poll *= 2; poll > lockMaxPoll {
+			poll = lockMaxPoll
+		}
+	}
+}
+
+// TryLock acquires an exclusive lock on the file at 'path', creating it and
+// its parent directories if necessary. Returns nil if the lock is held
+// elsewhere.
+func TryLock(path string) (*FileLock, error) {
+	f, err := openLockFile(path)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := tryLockFile(f)
+	if err != nil {
+		xio.CloseIgnoringErrors(f)
+		return nil, errs.NewfWithCause(err, "Unable to lock %s", path)
+	}
+	if !locked {
+		xio.CloseIgnoringErrors(f)
+		return nil, nil
+	}
+	return &FileLock{file: f}, nil
+}
+
+func openLockFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return f, nil
+}
+
+// Unlock releases the lock.
+func (l *FileLock) Unlock() error {
+	err := unlockFile(l.file)
+	if cerr := l.file.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
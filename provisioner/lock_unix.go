@@ -0,0 +1,24 @@
+//go:build !windows
+// +build !windows
+
+package provisioner
+
+import (
+	"os"
+	"syscall"
+)
+
+// tryLockFile returns false if the lock is held elsewhere.
+func tryLockFile(f *os.File) (bool, error) {
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		if err == syscall.EWOULDBLOCK {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,199 @@
+package provisioner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// VerificationMode determines how thoroughly previously deployed files are
+// checked before being reused.
+type VerificationMode int
+
+// Possible values for VerificationMode.
+const (
+	// QuickVerification compares the type, size, permissions and
+	// modification time of each file against those recorded when it was
+	// deployed, without reading its contents.
+	QuickVerification VerificationMode = iota
+	// FullVerification compares the type, size, permissions and content of
+	// each file against those recorded when it was deployed.
+	FullVerification
+)
+
+// manifestEntry records the state of a single deployed file or directory.
+type manifestEntry struct {
+	Path    string
+	Mode    os.FileMode
+	Size    int64  `yaml:",omitempty"`
+	ModTime int64  `yaml:",omitempty"`
+	Hash    string `yaml:",omitempty"`
+	Link    string `yaml:",omitempty"`
+	// Source is the name of a file within the deployment's source whose
+	// content is identical, if any.
+	Source string `yaml:",omitempty"`
+}
+
+// manifest records the state of every file and directory within a
+// deployment.
+type manifest []*manifestEntry
+
+// scanManifest builds a manifest of the tree at 'rootPath'. Hashes of file
+// contents are only computed when 'hash' is true.
+func scanManifest(rootPath string, hash bool) (manifest, error) {
+	var m manifest
+	statusPath := statusPath(rootPath)
+	if err := filepath.Walk(rootPath, func(path string, info os.FileInfo, e error) error {
+		if e != nil {
+			return e
+		}
+		if path == rootPath || path == statusPath || info.Name() == ".DS_Store" {
+			return nil
+		}
+		rel, err := filepath.Rel(rootPath, path)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		entry := &manifestEntry{Path: filepath.ToSlash(rel), Mode: info.Mode()}
+		switch {
+		case entry.Mode&os.ModeSymlink != 0:
+			if entry.Link, err = os.Readlink(path); err != nil {
+				return errs.Wrap(err)
+			}
+		case entry.Mode.IsRegular():
+			entry.Size = info.Size()
+			entry.ModTime = info.ModTime().UnixNano()
+		}
+		m = append(m, entry)
+		return nil
+	}); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if hash {
+		if err := m.hash(rootPath); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// hash fills in the content hash of each regular file in the manifest,
+// spreading the work across all available CPUs.
+func (m manifest) hash(rootPath string) error {
+	work := make(chan *manifestEntry)
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var firstErr error
+	for i := runtime.NumCPU(); i > 0; i-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range work {
+				h, err := hashFile(filepath.Join(rootPath, filepath.FromSlash(entry.Path)))
+				if err != nil {
+					lock.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					lock.Unlock()
+					continue
+				}
+				entry.Hash = h
+			}
+		}()
+	}
+	for _, entry := range m {
+		if entry.Mode.IsRegular() {
+			work <- entry
+		}
+	}
+	close(work)
+	wg.Wait()
+	return firstErr
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer xio.CloseIgnoringErrors(f)
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// differences returns the entries that are missing from or differ in
+// 'actual', followed by the paths in 'actual' that are not expected. When
+// 'mode' is FullVerification, 'actual' must include hashes.
+func (m manifest) differences(actual manifest, mode VerificationMode) (changed manifest, extraneous []string) {
+	found := make(map[string]*manifestEntry, len(actual))
+	for _, entry := range actual {
+		found[entry.Path] = entry
+	}
+	for _, expected := range m {
+		entry, ok := found[expected.Path]
+		if !ok || !expected.matches(entry, mode) {
+			changed = append(changed, expected)
+		}
+		delete(found, expected.Path)
+	}
+	for _, entry := range actual {
+		if _, ok := found[entry.Path]; ok {
+			extraneous = append(extraneous, entry.Path)
+		}
+	}
+	return changed, extraneous
+}
+
+// repairable returns true if every file in the manifest can be restored from
+// the deployment's source.
+func (m manifest) repairable() bool {
+	for _, entry := range m {
+		if entry.Mode.IsRegular() && entry.Source == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// assignSources records, for each file, a file in 'extracted' with identical
+// content. Files altered after extraction are left without a source.
+func (m manifest) assignSources(extracted manifest) {
+	byHash := make(map[string]string, len(extracted))
+	for _, entry := range extracted {
+		if entry.Mode.IsRegular() {
+			byHash[entry.Hash] = entry.Path
+		}
+	}
+	for _, entry := range m {
+		if entry.Mode.IsRegular() {
+			entry.Source = byHash[entry.Hash]
+		}
+	}
+}
+
+func (e *manifestEntry) matches(other *manifestEntry, mode VerificationMode) bool {
+	if e.Mode != other.Mode || e.Link != other.Link {
+		return false
+	}
+	if !e.Mode.IsRegular() {
+		return true
+	}
+	if e.Size != other.Size {
+		return false
+	}
+	if mode == FullVerification {
+		return e.Hash == other.Hash
+	}
+	return e.ModTime == other.ModTime
+}
@@ -0,0 +1,161 @@
+package provisioner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func scanTestManifest(t *testing.T, root string, hash bool) map[string]*manifestEntry {
+	t.Helper()
+	m, err := scanManifest(root, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := make(map[string]*manifestEntry, len(m))
+	for _, entry := range m {
+		entries[entry.Path] = entry
+	}
+	return entries
+}
+
+func TestScanManifest(t *testing.T) {
+	skipWithoutSymlinks(t)
+	root := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "dir", "file"), "hello")
+	writeTestFile(t, filepath.Join(root, "dir", ".DS_Store"), "ignored")
+	writeTestFile(t, statusPath(root), "ignored")
+	if err := os.Symlink("dir/file", filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	entries := scanTestManifest(t, root, false)
+	if len(entries) != 3 {
+		t.Errorf("expected 3 entries, got %d", len(entries))
+	}
+	if e := entries["dir"]; e == nil || !e.Mode.IsDir() {
+		t.Errorf("dir is %+v", e)
+	}
+	if e := entries["dir/file"]; e == nil || e.Size != 5 || e.ModTime == 0 || e.Hash != "" {
+		t.Errorf("dir/file is %+v", e)
+	}
+	if e := entries["link"]; e == nil || e.Mode&os.ModeSymlink == 0 || e.Link != "dir/file" {
+		t.Errorf("link is %+v", e)
+	}
+	entries = scanTestManifest(t, root, true)
+	// The SHA-256 of "hello".
+	if e := entries["dir/file"]; e.Hash != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected hash %s", e.Hash)
+	}
+}
+
+func TestManifestDifferences(t *testing.T) {
+	skipWithoutSymlinks(t)
+	root := t.TempDir()
+	for _, name := range []string{"same", "resized", "touched", "rewritten", "removed"} {
+		writeTestFile(t, filepath.Join(root, name), "hello")
+	}
+	if err := os.Symlink("same", filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(root, "retyped", "file"), "hello")
+	expected, err := scanManifest(root, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeTestFile(t, filepath.Join(root, "resized"), "hello, world")
+	later := time.Now().Add(time.Hour)
+	if err = os.Chtimes(filepath.Join(root, "touched"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	// Same size and modification time, different content.
+	fi, err := os.Stat(filepath.Join(root, "rewritten"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(root, "rewritten"), "HELLO")
+	if err = os.Chtimes(filepath.Join(root, "rewritten"), fi.ModTime(), fi.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(root, "removed")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink("resized", filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.RemoveAll(filepath.Join(root, "retyped")); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(root, "retyped"), "hello")
+	writeTestFile(t, filepath.Join(root, "extra", "file"), "hello")
+
+	for _, test := range []struct {
+		mode    VerificationMode
+		changed []string
+	}{
+		{QuickVerification, []string{"link", "removed", "resized", "retyped", "retyped/file", "touched"}},
+		{FullVerification, []string{"link", "removed", "resized", "retyped", "retyped/file", "rewritten"}},
+	} {
+		actual, err := scanManifest(root, test.mode == FullVerification)
+		if err != nil {
+			t.Fatal(err)
+		}
+		changed, extraneous := expected.differences(actual, test.mode)
+		var paths []string
+		for _, entry := range changed {
+			paths = append(paths, entry.Path)
+		}
+		if !equalStrings(paths, test.changed) {
+			t.Errorf("mode %d: expected changes %v, got %v", test.mode, test.changed, paths)
+		}
+		if !equalStrings(extraneous, []string{"extra", "extra/file"}) {
+			t.Errorf("mode %d: unexpected extraneous paths %v", test.mode, extraneous)
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestManifestSources(t *testing.T) {
+	m := manifest{
+		{Path: "dir", Mode: os.ModeDir | 0755},
+		{Path: "copied", Mode: 0644, Hash: "a"},
+		{Path: "duplicate", Mode: 0644, Hash: "a"},
+		{Path: "link", Mode: os.ModeSymlink | 0777, Link: "copied"},
+	}
+	m.assignSources(manifest{
+		{Path: "original", Mode: 0644, Hash: "a"},
+		{Path: "other", Mode: 0644, Hash: "b"},
+	})
+	for _, entry := range m {
+		expected := ""
+		if entry.Mode.IsRegular() {
+			expected = "original"
+		}
+		if entry.Source != expected {
+			t.Errorf("%s: expected source %q, got %q", entry.Path, expected, entry.Source)
+		}
+	}
+	if !m.repairable() {
+		t.Error("expected the manifest to be repairable")
+	}
+	m = append(m, &manifestEntry{Path: "finalized", Mode: 0644, Hash: "c"})
+	m.assignSources(manifest{{Path: "original", Mode: 0644, Hash: "a"}})
+	if m.repairable() {
+		t.Error("expected a file altered after extraction to make the manifest unrepairable")
+	}
+}
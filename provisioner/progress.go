@@ -0,0 +1,48 @@
+package provisioner
+
+import "io"
+
+// ProgressStage identifies the kind of work being reported to a
+// ProgressReporter.
+type ProgressStage int
+
+// Possible values for ProgressStage.
+const (
+	// DownloadStage reports the number of bytes downloaded.
+	DownloadStage ProgressStage = iota
+	// ExtractStage reports the number of files extracted.
+	ExtractStage
+)
+
+// ProgressReporter receives progress updates during provisioning. 'name'
+// identifies the item being worked on: the URL being downloaded or the
+// directory being extracted into. 'total' is -1 when it is not known.
+type ProgressReporter func(stage ProgressStage, name string, done, total int64)
+
+type progressReader struct {
+	r        io.Reader
+	progress ProgressReporter
+	stage    ProgressStage
+	name     string
+	done     int64
+	total    int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.done += int64(n)
+		r.progress(r.stage, r.name, r.done, r.total)
+	}
+	return n, err
+}
+
+// withProgress wraps 'r' so that reads are reported to 'progress', which may
+// be nil. 'done' is the amount already completed before reading from 'r'.
+func withProgress(r io.Reader, progress ProgressReporter, stage ProgressStage, name string, done, total int64) io.Reader {
+	if progress == nil {
+		return r
+	}
+	progress(stage, name, done, total)
+	return &progressReader{r: r, progress: progress, stage: stage, name: name, done: done, total: total}
+}
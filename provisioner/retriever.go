@@ -1,17 +1,147 @@
 package provisioner
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"os"
 
 	"github.com/richardwilkes/toolbox/errs"
 	"github.com/richardwilkes/toolbox/xio"
 )
 
-// ArchiveRetriever is used to retrieve the bytes for a zipped archive of
-// files that are to be provisioned.
+// ArchiveRetriever is used to retrieve the bytes for an archive of files that
+// are to be provisioned.
 type ArchiveRetriever func() ([]byte, error)
 
+// Archive provides random access to the bytes of an archive of files. Close
+// must be called once the archive is no longer needed.
+type Archive interface {
+	io.ReaderAt
+	io.Closer
+	Size() int64
+}
+
+// StreamingArchiveRetriever is used to retrieve an archive of files that are
+// to be provisioned without holding the entire archive in memory.
+type StreamingArchiveRetriever func() (Archive, error)
+
+type bytesArchive struct {
+	*bytes.Reader
+}
+
+func (a *bytesArchive) Close() error {
+	return nil
+}
+
+type fileArchive struct {
+	io.ReaderAt
+	io.Closer
+	size int64
+}
+
+func (a *fileArchive) Size() int64 {
+	return a.size
+}
+
+type tempFileArchive struct {
+	*os.File
+	size int64
+}
+
+func (a *tempFileArchive) Size() int64 {
+	return a.size
+}
+
+func (a *tempFileArchive) Close() error {
+	err := a.File.Close()
+	if rerr := os.Remove(a.Name()); rerr != nil && err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// spool copies the contents of a reader into a temporary file, which will be
+// removed when the returned Archive is closed.
+func spool(r io.Reader) (Archive, error) {
+	f, err := ioutil.TempFile("", "ion-archive-")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	a := &tempFileArchive{File: f}
+	if a.size, err = io.Copy(f, r); err != nil {
+		xio.CloseIgnoringErrors(a)
+		return nil, errs.Wrap(err)
+	}
+	return a, nil
+}
+
+// StreamingFromArchiveRetriever adapts an ArchiveRetriever for use where a
+// StreamingArchiveRetriever is needed.
+func StreamingFromArchiveRetriever(retriever ArchiveRetriever) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		data, err := retriever()
+		if err != nil {
+			return nil, err
+		}
+		return &bytesArchive{Reader: bytes.NewReader(data)}, nil
+	}
+}
+
+// FallbackStreamingArchiveRetriever returns a StreamingArchiveRetriever that
+// will try each of the provided StreamingArchiveRetrievers in turn until one
+// succeeds or none are left.
+func FallbackStreamingArchiveRetriever(retrievers ...StreamingArchiveRetriever) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		var cumulativeErr error
+		for _, r := range retrievers {
+			a, err := r()
+			if err == nil {
+				return a, nil
+			}
+			cumulativeErr = errs.Append(cumulativeErr, err)
+		}
+		return nil, cumulativeErr
+	}
+}
+
+// URLStreamingArchiveRetriever returns a StreamingArchiveRetriever that will
+// download the archive from a URL, spooling it to a temporary file. A single
+// attempt is made; use a Downloader for retries and timeouts. 'progress' may
+// be nil.
+func URLStreamingArchiveRetriever(client *http.Client, url string, progress ProgressReporter) StreamingArchiveRetriever {
+	return (&Downloader{Client: client, Progress: progress}).Retriever(context.Background(), url)
+}
+
+// FileSystemStreamingArchiveRetriever returns a StreamingArchiveRetriever
+// that will return the archive from a file system. If the file system's
+// files do not support random access, the archive is spooled to a temporary
+// file.
+func FileSystemStreamingArchiveRetriever(fs http.FileSystem, path string) StreamingArchiveRetriever {
+	return func() (Archive, error) {
+		f, err := fs.Open(path)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if ra, ok := f.(io.ReaderAt); ok {
+			var fi os.FileInfo
+			if fi, err = f.Stat(); err != nil {
+				xio.CloseIgnoringErrors(f)
+				return nil, errs.Wrap(err)
+			}
+			return &fileArchive{ReaderAt: ra, Closer: f, size: fi.Size()}, nil
+		}
+		defer xio.CloseIgnoringErrors(f)
+		a, err := spool(f)
+		if err != nil {
+			return nil, errs.NewfWithCause(err, "Failed to load %s", path)
+		}
+		return a, nil
+	}
+}
+
 // FallbackArchiveRetriever returns an ArchiveRetriever that will try each of
 // the provided ArchiveRetrievers in turn until one succeeds or none are left.
 func FallbackArchiveRetriever(retrievers ...ArchiveRetriever) ArchiveRetriever {
@@ -29,22 +159,23 @@ func FallbackArchiveRetriever(retrievers ...ArchiveRetriever) ArchiveRetriever {
 }
 
 // URLArchiveRetriever returns an ArchiveRetriever that will download the
-// archive from a URL.
+// archive from a URL. The download is made by DefaultDownloader() using
+// 'client', so failed attempts are retried and resumed. Use a Downloader
+// directly to adjust retries and timeouts or to cancel the download.
 func URLArchiveRetriever(client *http.Client, url string) ArchiveRetriever {
+	d := DefaultDownloader()
+	d.Client = client
 	return func() ([]byte, error) {
-		resp, err := client.Get(url)
+		a, err := d.Download(context.Background(), url)
 		if err != nil {
-			return nil, errs.Wrap(err)
+			return nil, err
 		}
-		defer xio.CloseIgnoringErrors(resp.Body)
-		data, err := ioutil.ReadAll(resp.Body)
+		defer xio.CloseIgnoringErrors(a)
+		data, err := ioutil.ReadAll(io.NewSectionReader(a, 0, a.Size()))
 		if err != nil {
 			return nil, errs.NewfWithCause(err, "Failed to download %s", url)
 		}
-		if resp.StatusCode != http.StatusOK {
-			err = errs.Newf("Attempted download of %s returned status code %d", url, resp.StatusCode)
-		}
-		return data, err
+		return data, nil
 	}
 }
 
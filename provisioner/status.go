@@ -3,12 +3,14 @@ package provisioner
 import (
 	"path/filepath"
 
+	"github.com/richardwilkes/toolbox/cmdline"
 	"github.com/richardwilkes/toolbox/xio/fs"
 )
 
 type status struct {
-	Version string
-	CRC64   uint64
+	Version  string
+	AppName  string
+	Manifest manifest
 }
 
 func statusPath(rootPath string) string {
@@ -23,6 +25,21 @@ func loadStatus(rootPath string) *status {
 	return &s
 }
 
+// record captures the current state of the deployment at 'rootPath' and
+// saves it. 'extracted' is the state of the deployment prior to
+// finalization.
+func (s *status) record(rootPath, version string, extracted manifest) error {
+	m, err := scanManifest(rootPath, true)
+	if err != nil {
+		return err
+	}
+	m.assignSources(extracted)
+	s.Version = version
+	s.AppName = cmdline.AppCmdName
+	s.Manifest = m
+	return s.save(rootPath)
+}
+
 func (s *status) save(rootPath string) error {
 	return fs.SaveYAML(statusPath(rootPath), s)
 }
@@ -0,0 +1,71 @@
+package ion
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+var defaultExternalURLSchemes = []string{"http", "https", "mailto"}
+
+type shellArgs struct {
+	Target string `json:"target,omitempty"`
+}
+
+// OpenExternal opens a URL in the desktop's default manner, e.g. http URLs in
+// the default browser. Only URLs whose scheme is in the allowlist set via the
+// ExternalURLSchemes option are permitted.
+func (ion *Ion) OpenExternal(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !ion.externalURLSchemeAllowed(parsed.Scheme) {
+		return errs.Newf("URL scheme '%s' is not permitted", parsed.Scheme)
+	}
+	return ion.invoke("shell.openExternal", &shellArgs{Target: u}, nil)
+}
+
+func (ion *Ion) externalURLSchemeAllowed(scheme string) bool {
+	allowed := ion.externalURLSchemes
+	if allowed == nil {
+		allowed = defaultExternalURLSchemes
+	}
+	for _, one := range allowed {
+		if strings.EqualFold(one, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenPath opens a file or directory with the desktop's default application.
+func (ion *Ion) OpenPath(path string) error {
+	return ion.shellPathCall("shell.openPath", path)
+}
+
+// ShowItemInFolder reveals a file or directory in the desktop's file
+// manager.
+func (ion *Ion) ShowItemInFolder(path string) error {
+	return ion.shellPathCall("shell.showItemInFolder", path)
+}
+
+// MoveItemToTrash moves a file or directory to the desktop's trash.
+func (ion *Ion) MoveItemToTrash(path string) error {
+	return ion.shellPathCall("shell.moveItemToTrash", path)
+}
+
+// Beep plays the system's beep sound.
+func (ion *Ion) Beep() error {
+	return ion.invoke("shell.beep", nil, nil)
+}
+
+func (ion *Ion) shellPathCall(cmd, path string) error {
+	p, err := filepath.Abs(path)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return ion.invoke(cmd, &shellArgs{Target: p}, nil)
+}
@@ -0,0 +1,31 @@
+package ion
+
+import "testing"
+
+func TestExternalURLSchemeAllowed(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		options []Option
+		allowed []string
+		denied  []string
+	}{
+		{"default", nil, []string{"http", "HTTPS", "mailto"}, []string{"file", "javascript", ""}},
+		{"custom", []Option{ExternalURLSchemes("ftp", "Zoommtg")}, []string{"ftp", "zoommtg"}, []string{"http", "https", "mailto"}},
+		{"empty", []Option{ExternalURLSchemes()}, nil, []string{"http", "https", "mailto"}},
+	} {
+		ion := &Ion{}
+		for _, option := range test.options {
+			option(ion)
+		}
+		for _, scheme := range test.allowed {
+			if !ion.externalURLSchemeAllowed(scheme) {
+				t.Errorf("%s: expected %q to be allowed", test.name, scheme)
+			}
+		}
+		for _, scheme := range test.denied {
+			if ion.externalURLSchemeAllowed(scheme) {
+				t.Errorf("%s: expected %q to be denied", test.name, scheme)
+			}
+		}
+	}
+}
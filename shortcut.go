@@ -0,0 +1,321 @@
+package ion
+
+import (
+	"encoding/json"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+const globalShortcutScope = 0
+
+// acceleratorAliases maps the tokens that may appear in an accelerator to
+// their canonical form. Window accelerators are parsed here rather than in
+// shortcut.js, so that both sides agree on which accelerators are
+// equivalent.
+var acceleratorAliases = newAcceleratorAliases(runtime.GOOS)
+
+// newAcceleratorAliases returns the alias table for a platform. Modifiers map
+// to the key that must be held: "meta", "control", "alt" or "shift".
+func newAcceleratorAliases(goos string) map[string]string {
+	cmdOrCtrl := "control"
+	if goos == "darwin" {
+		cmdOrCtrl = "meta"
+	}
+	return map[string]string{
+		"commandorcontrol": cmdOrCtrl,
+		"cmdorctrl":        cmdOrCtrl,
+		"command":          "meta",
+		"cmd":              "meta",
+		"super":            "meta",
+		"meta":             "meta",
+		"control":          "control",
+		"ctrl":             "control",
+		"alt":              "alt",
+		"option":           "alt",
+		"altgr":            "alt",
+		"shift":            "shift",
+		"return":           "enter",
+		"esc":              "escape",
+	}
+}
+
+// acceleratorModifiers holds the canonical modifiers.
+var acceleratorModifiers = map[string]bool{"meta": true, "control": true, "alt": true, "shift": true}
+
+// acceleratorCodes maps canonical key names to the physical key codes
+// reported in the 'code' field of keyboard input, for keys whose name alone
+// does not determine it.
+var acceleratorCodes = map[string][]string{
+	"plus":      {"Equal", "NumpadAdd"},
+	"=":         {"Equal"},
+	"-":         {"Minus"},
+	",":         {"Comma"},
+	".":         {"Period"},
+	"/":         {"Slash"},
+	";":         {"Semicolon"},
+	"'":         {"Quote"},
+	"[":         {"BracketLeft"},
+	"]":         {"BracketRight"},
+	"\\":        {"Backslash"},
+	"`":         {"Backquote"},
+	"space":     {"Space"},
+	"tab":       {"Tab"},
+	"enter":     {"Enter", "NumpadEnter"},
+	"escape":    {"Escape"},
+	"backspace": {"Backspace"},
+	"delete":    {"Delete"},
+	"insert":    {"Insert"},
+	"home":      {"Home"},
+	"end":       {"End"},
+	"pageup":    {"PageUp"},
+	"pagedown":  {"PageDown"},
+	"up":        {"ArrowUp"},
+	"down":      {"ArrowDown"},
+	"left":      {"ArrowLeft"},
+	"right":     {"ArrowRight"},
+	"numdec":    {"NumpadDecimal"},
+	"numadd":    {"NumpadAdd"},
+	"numsub":    {"NumpadSubtract"},
+	"nummult":   {"NumpadMultiply"},
+	"numdiv":    {"NumpadDivide"},
+}
+
+type shortcutArgs struct {
+	ID          int64              `json:"id,omitempty"`
+	Accelerator string             `json:"accelerator,omitempty"`
+	Match       *windowAccelerator `json:"match,omitempty"`
+}
+
+// windowAccelerator describes the keyboard input that triggers a window
+// shortcut. Keys are matched by their physical code rather than the
+// character they produce, which depends on the keyboard layout and the
+// modifiers held; Option+S produces "ß" on macOS, for example.
+type windowAccelerator struct {
+	Keys    []acceleratorKey `json:"keys"`
+	Meta    bool             `json:"meta"`
+	Control bool             `json:"control"`
+	Alt     bool             `json:"alt"`
+}
+
+// acceleratorKey is one key, and the state of Shift, that may complete a
+// window accelerator. Code is empty when no physical key is known for the
+// accelerator's key, in which case Key is matched against the character
+// produced instead.
+type acceleratorKey struct {
+	Code  string `json:"code,omitempty"`
+	Key   string `json:"key,omitempty"`
+	Shift bool   `json:"shift"`
+}
+
+// parseWindowAccelerator returns the input that matches an accelerator.
+func parseWindowAccelerator(accelerator string) (*windowAccelerator, error) {
+	parts := strings.Split(normalizeAccelerator(accelerator), "+")
+	key := parts[len(parts)-1]
+	if key == "" {
+		return nil, errs.Newf("Invalid accelerator: %s", accelerator)
+	}
+	var wa windowAccelerator
+	shift := false
+	for _, part := range parts[:len(parts)-1] {
+		if !acceleratorModifiers[part] {
+			return nil, errs.Newf("Invalid accelerator: %s", accelerator)
+		}
+		switch part {
+		case "meta":
+			wa.Meta = true
+		case "control":
+			wa.Control = true
+		case "alt":
+			wa.Alt = true
+		case "shift":
+			shift = true
+		}
+	}
+	for _, code := range acceleratorKeyCodes(key) {
+		k := acceleratorKey{Code: code, Shift: shift}
+		if key == "plus" && code == "Equal" {
+			// '+' shares its key with '=', so Shift is needed to type it.
+			k.Shift = true
+		}
+		wa.Keys = append(wa.Keys, k)
+	}
+	if len(wa.Keys) == 0 {
+		wa.Keys = []acceleratorKey{{Key: key, Shift: shift}}
+	}
+	return &wa, nil
+}
+
+// acceleratorKeyCodes returns the physical key codes for a canonical key
+// name, or nil if they are not known.
+func acceleratorKeyCodes(key string) []string {
+	if codes, ok := acceleratorCodes[key]; ok {
+		return codes
+	}
+	if len(key) == 1 {
+		switch c := key[0]; {
+		case c >= 'a' && c <= 'z':
+			return []string{"Key" + strings.ToUpper(key)}
+		case c >= '0' && c <= '9':
+			return []string{"Digit" + key}
+		}
+	}
+	if n, err := strconv.Atoi(strings.TrimPrefix(key, "f")); err == nil && strings.HasPrefix(key, "f") && n >= 1 && n <= 24 {
+		return []string{strings.ToUpper(key)}
+	}
+	if n, err := strconv.Atoi(strings.TrimPrefix(key, "num")); err == nil && strings.HasPrefix(key, "num") && n >= 0 && n <= 9 {
+		return []string{"Numpad" + strconv.Itoa(n)}
+	}
+	return nil
+}
+
+// RegisterGlobalShortcut registers an accelerator, such as
+// "CommandOrControl+Shift+K", that is active even when the application does
+// not have keyboard focus. When pressed, an event.GlobalShortcut event is
+// sent. An error is returned if the accelerator is already registered by
+// this application or could not be registered with the system, which
+// usually means another application has claimed it.
+func (ion *Ion) RegisterGlobalShortcut(accelerator string) error {
+	return ion.registerShortcut(globalShortcutScope, accelerator, "shortcut.registerGlobal")
+}
+
+// UnregisterGlobalShortcut removes a global shortcut.
+func (ion *Ion) UnregisterGlobalShortcut(accelerator string) error {
+	return ion.unregisterShortcut(globalShortcutScope, accelerator, "shortcut.unregisterGlobal")
+}
+
+// RegisterShortcut registers an accelerator, such as "CommandOrControl+S",
+// that is active while this window has keyboard focus. When pressed, an
+// event.WindowShortcut event is sent. An error is returned if the
+// accelerator is already registered for this window or as a global
+// shortcut.
+func (w *Window) RegisterShortcut(accelerator string) error {
+	return w.ion.registerShortcut(w.id, accelerator, "shortcut.registerWindow")
+}
+
+// UnregisterShortcut removes a window shortcut.
+func (w *Window) UnregisterShortcut(accelerator string) error {
+	return w.ion.unregisterShortcut(w.id, accelerator, "shortcut.unregisterWindow")
+}
+
+func (ion *Ion) registerShortcut(scope int64, accelerator, cmd string) error {
+	args := &shortcutArgs{ID: scope, Accelerator: accelerator}
+	if scope != globalShortcutScope {
+		var err error
+		if args.Match, err = parseWindowAccelerator(accelerator); err != nil {
+			return err
+		}
+	}
+	key := normalizeAccelerator(accelerator)
+	if err := ion.reserveShortcut(scope, key, accelerator); err != nil {
+		return err
+	}
+	// The lock is not held while waiting on Electron, so that a stalled call
+	// cannot block shutdown.
+	if err := ion.invoke(cmd, args, nil); err != nil {
+		ion.shortcutLock.Lock()
+		ion.forgetShortcut(scope, key)
+		ion.shortcutLock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// reserveShortcut records a shortcut ahead of its registration with
+// Electron, so that concurrent attempts to register it conflict.
+func (ion *Ion) reserveShortcut(scope int64, key, accelerator string) error {
+	ion.shortcutLock.Lock()
+	defer ion.shortcutLock.Unlock()
+	if ion.shortcuts[globalShortcutScope][key] {
+		return errs.Newf("%s is already registered as a global shortcut", accelerator)
+	}
+	if ion.shortcuts[scope][key] {
+		return errs.Newf("%s is already registered for this window", accelerator)
+	}
+	if scope == globalShortcutScope {
+		for _, one := range ion.shortcuts {
+			if one[key] {
+				return errs.Newf("%s is already registered as a window shortcut", accelerator)
+			}
+		}
+	}
+	if ion.shortcuts[scope] == nil {
+		ion.shortcuts[scope] = make(map[string]bool)
+	}
+	ion.shortcuts[scope][key] = true
+	return nil
+}
+
+// forgetShortcut removes a shortcut from the record. The caller must hold
+// shortcutLock.
+func (ion *Ion) forgetShortcut(scope int64, key string) {
+	delete(ion.shortcuts[scope], key)
+	if len(ion.shortcuts[scope]) == 0 {
+		delete(ion.shortcuts, scope)
+	}
+}
+
+func (ion *Ion) unregisterShortcut(scope int64, accelerator, cmd string) error {
+	key := normalizeAccelerator(accelerator)
+	ion.shortcutLock.Lock()
+	registered := ion.shortcuts[scope][key]
+	ion.shortcutLock.Unlock()
+	if !registered {
+		return errs.Newf("%s is not registered", accelerator)
+	}
+	if err := ion.invoke(cmd, &shortcutArgs{ID: scope, Accelerator: accelerator}, nil); err != nil {
+		return err
+	}
+	ion.shortcutLock.Lock()
+	ion.forgetShortcut(scope, key)
+	ion.shortcutLock.Unlock()
+	return nil
+}
+
+// handleWindowClosed forgets the shortcuts of a window that has closed.
+func (ion *Ion) handleWindowClosed(args json.RawMessage) {
+	var sa shortcutArgs
+	if err := json.Unmarshal(args, &sa); err != nil {
+		ion.logger.Error(errs.NewWithCause("Invalid window closed notification", err))
+		return
+	}
+	if sa.ID == globalShortcutScope {
+		return
+	}
+	ion.shortcutLock.Lock()
+	delete(ion.shortcuts, sa.ID)
+	ion.shortcutLock.Unlock()
+}
+
+// unregisterAllShortcuts asks Electron to drop all shortcuts without waiting
+// for a reply, as it is called while shutting down.
+func (ion *Ion) unregisterAllShortcuts() {
+	ion.shortcutLock.Lock()
+	defer ion.shortcutLock.Unlock()
+	if len(ion.shortcuts) != 0 {
+		ion.shortcuts = make(map[int64]map[string]bool)
+		if err := ion.send(&request{Cmd: "shortcut.unregisterAll"}); err != nil {
+			ion.logger.Error(err)
+		}
+	}
+}
+
+func normalizeAccelerator(accelerator string) string {
+	return normalizeAcceleratorWithAliases(accelerator, acceleratorAliases)
+}
+
+func normalizeAcceleratorWithAliases(accelerator string, aliases map[string]string) string {
+	parts := strings.Split(strings.ToLower(strings.Replace(accelerator, " ", "", -1)), "+")
+	for i, part := range parts {
+		if alias, ok := aliases[part]; ok {
+			parts[i] = alias
+		}
+	}
+	if len(parts) > 1 {
+		sort.Strings(parts[:len(parts)-1])
+	}
+	return strings.Join(parts, "+")
+}
@@ -0,0 +1,72 @@
+package ion
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAcceleratorAliases(t *testing.T) {
+	for _, goos := range []string{"darwin", "linux", "windows"} {
+		aliases := newAcceleratorAliases(goos)
+		for alias, canonical := range aliases {
+			if again, ok := aliases[canonical]; ok && again != canonical {
+				t.Errorf("%s: canonical form %s of %s is itself an alias of %s", goos, canonical, alias, again)
+			}
+			for _, format := range []string{"%s+K", "Shift+%s"} {
+				if a, b := normalizeAcceleratorWithAliases(fmt.Sprintf(format, alias), aliases), normalizeAcceleratorWithAliases(fmt.Sprintf(format, canonical), aliases); a != b {
+					t.Errorf("%s: expected %s and %s to be equivalent, got %s and %s", goos, alias, canonical, a, b)
+				}
+			}
+		}
+		for _, accelerator := range []string{"Cmd+X", "Command+X", "Super+X", "Meta+X"} {
+			if key := normalizeAcceleratorWithAliases(accelerator, aliases); key != "meta+x" {
+				t.Errorf("%s: expected %s to normalize to meta+x, got %s", goos, accelerator, key)
+			}
+		}
+		cmdOrCtrl := "control+x"
+		if goos == "darwin" {
+			cmdOrCtrl = "meta+x"
+		}
+		for _, accelerator := range []string{"CmdOrCtrl+X", "CommandOrControl+X"} {
+			if key := normalizeAcceleratorWithAliases(accelerator, aliases); key != cmdOrCtrl {
+				t.Errorf("%s: expected %s to normalize to %s, got %s", goos, accelerator, cmdOrCtrl, key)
+			}
+		}
+		if a, b := normalizeAcceleratorWithAliases("Shift+Option+Return", aliases), normalizeAcceleratorWithAliases("alt + shift + enter", aliases); a != b {
+			t.Errorf("%s: expected equivalent accelerators to match, got %s and %s", goos, a, b)
+		}
+	}
+}
+
+func TestParseWindowAccelerator(t *testing.T) {
+	for _, one := range []struct {
+		accelerator string
+		expected    windowAccelerator
+	}{
+		{"Alt+S", windowAccelerator{Alt: true, Keys: []acceleratorKey{{Code: "KeyS"}}}},
+		{"Option+Shift+S", windowAccelerator{Alt: true, Keys: []acceleratorKey{{Code: "KeyS", Shift: true}}}},
+		{"Shift+1", windowAccelerator{Keys: []acceleratorKey{{Code: "Digit1", Shift: true}}}},
+		{"Ctrl+Plus", windowAccelerator{Control: true, Keys: []acceleratorKey{{Code: "Equal", Shift: true}, {Code: "NumpadAdd"}}}},
+		{"Ctrl+=", windowAccelerator{Control: true, Keys: []acceleratorKey{{Code: "Equal"}}}},
+		{"Meta+Return", windowAccelerator{Meta: true, Keys: []acceleratorKey{{Code: "Enter"}, {Code: "NumpadEnter"}}}},
+		{"Control+F12", windowAccelerator{Control: true, Keys: []acceleratorKey{{Code: "F12"}}}},
+		{"Control+num7", windowAccelerator{Control: true, Keys: []acceleratorKey{{Code: "Numpad7"}}}},
+		{"Control+Up", windowAccelerator{Control: true, Keys: []acceleratorKey{{Code: "ArrowUp"}}}},
+		{"Control+VolumeUp", windowAccelerator{Control: true, Keys: []acceleratorKey{{Key: "volumeup"}}}},
+	} {
+		wa, err := parseWindowAccelerator(one.accelerator)
+		if err != nil {
+			t.Errorf("%s: %v", one.accelerator, err)
+			continue
+		}
+		if !reflect.DeepEqual(*wa, one.expected) {
+			t.Errorf("%s: expected %+v, got %+v", one.accelerator, one.expected, *wa)
+		}
+	}
+	for _, accelerator := range []string{"", "Control+", "Hyper+S", "S+T"} {
+		if _, err := parseWindowAccelerator(accelerator); err == nil {
+			t.Errorf("expected %q to be rejected", accelerator)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package ion
+
+// Tray provides an icon in the system's notification area.
+type Tray struct {
+	ion *Ion
+	id  int64
+}
+
+type trayArgs struct {
+	ID   int64       `json:"id"`
+	Icon []byte      `json:"icon,omitempty"`
+	Text string      `json:"text,omitempty"`
+	Menu []*MenuItem `json:"menu,omitempty"`
+}
+
+// NewTray creates a new tray icon. 'iconPath' is the path to an image within
+// the file system set via the IconFileSystem option.
+func (ion *Ion) NewTray(iconPath string) (*Tray, error) {
+	icon, err := ion.loadIcon(iconPath)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tray{ion: ion, id: ion.nextID()}
+	if err = ion.invoke("tray.create", &trayArgs{ID: t.id, Icon: icon}, nil); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ID returns the ID of the tray. Events originating from this tray will
+// carry this value in their TrayID field.
+func (t *Tray) ID() int64 {
+	return t.id
+}
+
+// SetIcon changes the icon. 'iconPath' is the path to an image within the
+// file system set via the IconFileSystem option.
+func (t *Tray) SetIcon(iconPath string) error {
+	icon, err := t.ion.loadIcon(iconPath)
+	if err != nil {
+		return err
+	}
+	return t.ion.invoke("tray.setIcon", &trayArgs{ID: t.id, Icon: icon}, nil)
+}
+
+// SetToolTip sets the text shown when hovering over the tray icon.
+func (t *Tray) SetToolTip(tip string) error {
+	return t.ion.invoke("tray.setToolTip", &trayArgs{ID: t.id, Text: tip}, nil)
+}
+
+// SetTitle sets the title displayed next to the tray icon. Only has an effect
+// on macOS.
+func (t *Tray) SetTitle(title string) error {
+	return t.ion.invoke("tray.setTitle", &trayArgs{ID: t.id, Text: title}, nil)
+}
+
+// SetMenu sets the context menu for the tray. Pass nil to remove it.
+func (t *Tray) SetMenu(menu []*MenuItem) error {
+	return t.ion.invoke("tray.setMenu", &trayArgs{ID: t.id, Menu: menu}, nil)
+}
+
+// Destroy removes the tray icon. The Tray should not be used after this call.
+func (t *Tray) Destroy() error {
+	return t.ion.invoke("tray.destroy", &trayArgs{ID: t.id}, nil)
+}
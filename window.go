@@ -0,0 +1,94 @@
+package ion
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// Window refers to an Electron browser window.
+type Window struct {
+	ion *Ion
+	id  int64
+}
+
+type windowArgs struct {
+	ID    int64    `json:"id"`
+	Code  string   `json:"code,omitempty"`
+	Paths []string `json:"paths,omitempty"`
+	Icon  []byte   `json:"icon,omitempty"`
+}
+
+// Window returns a reference to the window with the given ID.
+func (ion *Ion) Window(id int64) *Window {
+	return &Window{ion: ion, id: id}
+}
+
+// Windows returns the currently open windows.
+func (ion *Ion) Windows() ([]*Window, error) {
+	var ids []int64
+	if err := ion.invoke("window.list", nil, &ids); err != nil {
+		return nil, err
+	}
+	windows := make([]*Window, len(ids))
+	for i, id := range ids {
+		windows[i] = ion.Window(id)
+	}
+	return windows, nil
+}
+
+// MainWindow returns the main window, or nil if it has been closed.
+func (ion *Ion) MainWindow() (*Window, error) {
+	var id int64
+	if err := ion.invoke("window.main", nil, &id); err != nil {
+		return nil, err
+	}
+	if id == 0 {
+		return nil, nil
+	}
+	return ion.Window(id), nil
+}
+
+// ID returns the ID of the window. Events originating from this window will
+// carry this value in their WindowID field.
+func (w *Window) ID() int64 {
+	return w.id
+}
+
+// ExecuteJavaScript evaluates 'code' in the window's web page and returns the
+// result. If the result is a promise, it is awaited. If evaluation throws,
+// the returned error will be a *RemoteError with the JavaScript message and
+// stack as its cause. That detail depends on eval, so it is lost for pages
+// whose Content Security Policy forbids eval; the code still runs.
+func (w *Window) ExecuteJavaScript(ctx context.Context, code string) (json.RawMessage, error) {
+	var result json.RawMessage
+	if err := w.ion.call(ctx, "window.executeJavaScript", &windowArgs{ID: w.id, Code: code}, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StartDrag begins a native drag of the given files out of the window.
+// 'iconPath' is the path to an image within the file system set via the
+// IconFileSystem option, which will be shown under the cursor. This should
+// be called while the user is dragging, typically in response to a message
+// sent by the page from a "dragstart" handler.
+func (w *Window) StartDrag(paths []string, iconPath string) error {
+	if len(paths) == 0 {
+		return errs.New("no files to drag")
+	}
+	abs := make([]string, len(paths))
+	for i, p := range paths {
+		var err error
+		if abs[i], err = filepath.Abs(p); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	icon, err := w.ion.loadIcon(iconPath)
+	if err != nil {
+		return err
+	}
+	return w.ion.invoke("window.startDrag", &windowArgs{ID: w.id, Paths: abs, Icon: icon}, nil)
+}